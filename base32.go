@@ -0,0 +1,40 @@
+package fastuuid
+
+import (
+	"encoding/base32"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// base32Len128 holds the length of the base32 encoding
+// of 128 bits without padding.
+const base32Len128 = 26
+
+var base32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Base32_128 returns the first 16 bytes of uuid encoded as
+// lower case base32 using the standard RFC 4648 alphabet,
+// with the padding removed. The result is always 26
+// characters long. No version or variant bits are changed.
+func Base32_128(uuid [24]byte) string {
+	return strings.ToLower(base32Encoding.EncodeToString(uuid[:16]))
+}
+
+// ParseBase32_128 parses a string in the format returned
+// by Base32_128 into the first 16 bytes of a UUID.
+// As specified by RFC 4648, either case is accepted.
+// The remaining bytes are zero.
+func ParseBase32_128(s string) ([24]byte, error) {
+	var uuid [24]byte
+	if len(s) != base32Len128 {
+		return uuid, errors.New("invalid base32 UUID " + strconv.Quote(s))
+	}
+	_, err := base32Encoding.Decode(uuid[:16], []byte(strings.ToUpper(s)))
+	// Reject encodings with non-zero trailing bits so
+	// that each UUID has exactly one valid encoding.
+	if err != nil || !strings.EqualFold(Base32_128(uuid), s) {
+		return [24]byte{}, errors.New("invalid base32 UUID " + strconv.Quote(s))
+	}
+	return uuid, nil
+}
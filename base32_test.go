@@ -0,0 +1,55 @@
+package fastuuid
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+)
+
+func TestBase32_128(t *testing.T) {
+	var uuid [24]byte
+	for i := range uuid {
+		uuid[i] = byte(i + 1)
+	}
+	got, want := Base32_128(uuid), "aebagbafaydqqcikbmga2dqpca"
+	if got != want {
+		t.Fatalf("unexpected Base32_128 result; got %q want %q", got, want)
+	}
+}
+
+func TestBase32_128RoundTrip(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		var uuid [24]byte
+		rand.Read(uuid[:16])
+		s := Base32_128(uuid)
+		for _, s := range []string{s, strings.ToUpper(s)} {
+			got, err := ParseBase32_128(s)
+			if err != nil {
+				t.Fatalf("cannot parse %q: %v", s, err)
+			}
+			if got != uuid {
+				t.Fatalf("round trip mismatch for %q; got %x want %x", s, got, uuid)
+			}
+		}
+	}
+}
+
+var parseBase32_128ErrorTests = []string{
+	"",
+	"aebagbafaydqqcikbmga2dqpc",
+	"aebagbafaydqqcikbmga2dqpcaa",
+	"aebagbafaydqqcikbmga2dqpc=",
+	"aebagbafaydqqcikbmga2dqpc1",
+	// Non-zero trailing bits.
+	"aebagbafaydqqcikbmga2dqpcb",
+}
+
+func TestParseBase32_128Error(t *testing.T) {
+	for _, s := range parseBase32_128ErrorTests {
+		t.Run(s, func(t *testing.T) {
+			if uuid, err := ParseBase32_128(s); err == nil {
+				t.Fatalf("expected error, got %x", uuid)
+			}
+		})
+	}
+}
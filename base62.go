@@ -0,0 +1,155 @@
+package fastuuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"strconv"
+)
+
+// base62Len128 holds the number of base62 digits
+// needed to represent any 128 bit value.
+const base62Len128 = 22
+
+// base62Digits holds the base62 alphabet in ASCII order,
+// so that encoded strings sort in the same order as the
+// values they encode.
+const base62Digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// ErrBase62Range is returned by ParseBase62_128 when the
+// string is well formed but represents a value that does
+// not fit in 128 bits.
+var ErrBase62Range = errors.New("base62 UUID out of range")
+
+// Base62_128 returns the first 16 bytes of uuid interpreted as a
+// big-endian unsigned integer, formatted in base62 using the
+// digits 0-9, A-Z and a-z and zero-padded to 22 digits. Because
+// the width is fixed and the digits are in ASCII order, the
+// strings sort in the same order as the numbers.
+func Base62_128(uuid [24]byte) string {
+	return formatRadix128(uuid, base62Digits, base62Len128)
+}
+
+// ParseBase62_128 parses a string in the format returned by
+// Base62_128 into the first 16 bytes of a UUID. The remaining
+// bytes are zero. Some 22 digit strings represent values of
+// 2^128 or more; for those it returns ErrBase62Range.
+func ParseBase62_128(s string) ([24]byte, error) {
+	if len(s) != base62Len128 {
+		return [24]byte{}, errors.New("invalid base62 UUID " + strconv.Quote(s))
+	}
+	uuid, ok, inRange := parseRadix128(s, 62, fromBase62Char)
+	if !ok {
+		return [24]byte{}, errors.New("invalid base62 UUID " + strconv.Quote(s))
+	}
+	if !inRange {
+		return [24]byte{}, ErrBase62Range
+	}
+	return uuid, nil
+}
+
+// formatRadix128 returns the first 16 bytes of uuid interpreted
+// as a big-endian unsigned integer, formatted with the given
+// digits as exactly n digits, most significant first.
+func formatRadix128(uuid [24]byte, digits string, n int) string {
+	hi := binary.BigEndian.Uint64(uuid[0:8])
+	lo := binary.BigEndian.Uint64(uuid[8:16])
+	radix := uint64(len(digits))
+	buf := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		var r uint64
+		hi, r = bits.Div64(0, hi, radix)
+		lo, r = bits.Div64(r, lo, radix)
+		buf[i] = digits[r]
+	}
+	return string(buf)
+}
+
+// parseRadix128 parses s as an unsigned integer in the given radix,
+// using digitValue to map each character to its value, into the first
+// 16 bytes of a UUID in big-endian order. It reports whether all the
+// characters were valid and, if so, whether the value fits in 128
+// bits, detecting overflow as each digit is added rather than
+// silently wrapping.
+func parseRadix128(s string, radix uint64, digitValue func(byte) (uint64, bool)) (uuid [24]byte, ok, inRange bool) {
+	var hi, lo uint64
+	inRange = true
+	for i := 0; i < len(s); i++ {
+		d, ok := digitValue(s[i])
+		if !ok {
+			return [24]byte{}, false, false
+		}
+		if !inRange {
+			continue
+		}
+		// Compute hi:lo = hi:lo*radix + d, checking for
+		// overflow beyond 128 bits at each step.
+		carry, h := bits.Mul64(hi, radix)
+		c, l := bits.Mul64(lo, radix)
+		h, carry1 := bits.Add64(h, c, 0)
+		l, carry2 := bits.Add64(l, d, 0)
+		h, carry3 := bits.Add64(h, 0, carry2)
+		if carry|carry1|carry3 != 0 {
+			inRange = false
+			continue
+		}
+		hi, lo = h, l
+	}
+	if !inRange {
+		return [24]byte{}, true, false
+	}
+	binary.BigEndian.PutUint64(uuid[0:8], hi)
+	binary.BigEndian.PutUint64(uuid[8:16], lo)
+	return uuid, true, true
+}
+
+func fromBase62Char(c byte) (uint64, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return uint64(c - '0'), true
+	case 'A' <= c && c <= 'Z':
+		return uint64(c-'A') + 10, true
+	case 'a' <= c && c <= 'z':
+		return uint64(c-'a') + 36, true
+	}
+	return 0, false
+}
+
+// maxSlugLen holds the maximum length of a slug returned by
+// UUID.Slug. 32 base62 digits hold just under 192 bits, the
+// size of a UUID.
+const maxSlugLen = 32
+
+// Slug returns a short URL-safe string of exactly chars base62
+// digits derived from uuid, for use in shareable links. The
+// digits are taken from a SHA-256 hash of all 24 bytes, so that
+// UUIDs differing only in their counter have unrelated slugs.
+// The result is deterministic.
+//
+// A slug holds about 5.95 bits per character, so among n
+// distinct UUIDs the probability that any two share a slug is
+// approximately n*n / (2 * 62^chars). For example, with 8
+// characters there is about a 1% chance of a collision among
+// 2 million UUIDs; with 12 characters, among 8 billion.
+//
+// It panics if chars is less than 1 or greater than 32.
+func (uuid UUID) Slug(chars int) string {
+	if chars < 1 || chars > maxSlugLen {
+		panic("fastuuid: invalid length passed to Slug")
+	}
+	sum := sha256.Sum256(uuid[:])
+	var words [4]uint64
+	for i := range words {
+		words[i] = binary.BigEndian.Uint64(sum[i*8:])
+	}
+	buf := make([]byte, chars)
+	for i := range buf {
+		var r uint64
+		for j := range words {
+			words[j], r = bits.Div64(r, words[j], 62)
+		}
+		buf[i] = base62Digits[r]
+	}
+	return string(buf)
+}
@@ -0,0 +1,184 @@
+package fastuuid
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+var base62Tests = []struct {
+	uuid [24]byte
+	want string
+}{{
+	uuid: [24]byte{},
+	want: "0000000000000000000000",
+}, {
+	uuid: [24]byte{15: 61},
+	want: "000000000000000000000z",
+}, {
+	uuid: [24]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+	want: "01tuWckR0Qgud2DqqiTysq",
+}, {
+	uuid: [24]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	want: "7n42DGM5Tflk9n8mt7Fhc7",
+}}
+
+func TestBase62_128(t *testing.T) {
+	for _, test := range base62Tests {
+		if got := Base62_128(test.uuid); got != test.want {
+			t.Errorf("unexpected Base62_128 result for %x; got %q want %q", test.uuid, got, test.want)
+		}
+		uuid, err := ParseBase62_128(test.want)
+		if err != nil {
+			t.Errorf("cannot parse %q: %v", test.want, err)
+			continue
+		}
+		if uuid != test.uuid {
+			t.Errorf("unexpected ParseBase62_128 result for %q; got %x want %x", test.want, uuid, test.uuid)
+		}
+	}
+}
+
+func TestBase62_128Order(t *testing.T) {
+	g := MustNewGenerator()
+	prev := g.Next()
+	for i := 0; i < 1000; i++ {
+		uuid := g.Next()
+		if (bytes.Compare(prev[:16], uuid[:16]) < 0) != (Base62_128(prev) < Base62_128(uuid)) {
+			t.Fatalf("base62 order differs from byte order for %x and %x", prev, uuid)
+		}
+		prev = uuid
+	}
+}
+
+var parseBase62ErrorTests = []struct {
+	s       string
+	wantErr string
+}{{
+	s:       "",
+	wantErr: `invalid base62 UUID ""`,
+}, {
+	s:       "000000000000000000000",
+	wantErr: `invalid base62 UUID "000000000000000000000"`,
+}, {
+	s:       "00000000000000000000000",
+	wantErr: `invalid base62 UUID "00000000000000000000000"`,
+}, {
+	s:       "000000000000000000000-",
+	wantErr: `invalid base62 UUID "000000000000000000000-"`,
+}, {
+	// 2^128, one more than the largest valid value.
+	s:       "7n42DGM5Tflk9n8mt7Fhc8",
+	wantErr: "base62 UUID out of range",
+}, {
+	s:       "7n42DGM5Tflk9n8mt7Fhd0",
+	wantErr: "base62 UUID out of range",
+}, {
+	s:       "zzzzzzzzzzzzzzzzzzzzzz",
+	wantErr: "base62 UUID out of range",
+}}
+
+func TestParseBase62_128Error(t *testing.T) {
+	for _, test := range parseBase62ErrorTests {
+		uuid, err := ParseBase62_128(test.s)
+		if err == nil || err.Error() != test.wantErr {
+			t.Errorf("unexpected error for %q; got %v want %q", test.s, err, test.wantErr)
+		}
+		if uuid != [24]byte{} {
+			t.Errorf("non-zero UUID returned on error for %q", test.s)
+		}
+	}
+	if _, err := ParseBase62_128("7n42DGM5Tflk9n8mt7Fhc8"); err != ErrBase62Range {
+		t.Errorf("unexpected error for out of range value; got %v want ErrBase62Range", err)
+	}
+}
+
+func FuzzParseBase62_128(f *testing.F) {
+	for _, test := range base62Tests {
+		f.Add(test.want)
+	}
+	for _, test := range parseBase62ErrorTests {
+		f.Add(test.s)
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), 128)
+	f.Fuzz(func(t *testing.T, s string) {
+		uuid, err := ParseBase62_128(s)
+		if len(s) != base62Len128 {
+			if err == nil {
+				t.Fatalf("no error for wrong length string %q", s)
+			}
+			return
+		}
+		// Compute the value independently to check the
+		// range check.
+		x := new(big.Int)
+		for i := 0; i < len(s); i++ {
+			d, ok := fromBase62Char(s[i])
+			if !ok {
+				if err == nil {
+					t.Fatalf("no error for invalid string %q", s)
+				}
+				return
+			}
+			x.Mul(x, big.NewInt(62))
+			x.Add(x, new(big.Int).SetUint64(d))
+		}
+		if x.Cmp(limit) >= 0 {
+			if err != ErrBase62Range {
+				t.Fatalf("unexpected error for out of range %q; got %v want ErrBase62Range", s, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", s, err)
+		}
+		if got := Base62_128(uuid); got != s {
+			t.Fatalf("round trip mismatch; got %q want %q", got, s)
+		}
+	})
+}
+
+func TestSlug(t *testing.T) {
+	g := MustNewGenerator()
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		uuid := UUID(g.Next())
+		for _, chars := range []int{1, 8, 32} {
+			s := uuid.Slug(chars)
+			if len(s) != chars {
+				t.Fatalf("unexpected slug length; got %d want %d", len(s), chars)
+			}
+			if s != uuid.Slug(chars) {
+				t.Fatalf("Slug is not deterministic")
+			}
+			for j := 0; j < len(s); j++ {
+				if _, ok := fromBase62Char(s[j]); !ok {
+					t.Fatalf("invalid character in slug %q", s)
+				}
+			}
+		}
+		s := uuid.Slug(8)
+		if seen[s] {
+			t.Fatalf("duplicate slug %q", s)
+		}
+		seen[s] = true
+	}
+	// A shorter slug is a prefix of a longer one.
+	uuid := UUID(g.Next())
+	if got, want := uuid.Slug(5), uuid.Slug(10)[:5]; got != want {
+		t.Fatalf("unexpected short slug; got %q want %q", got, want)
+	}
+}
+
+func TestSlugInvalidLength(t *testing.T) {
+	for _, chars := range []int{-1, 0, 33} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for length %d", chars)
+				}
+			}()
+			UUID{}.Slug(chars)
+		}()
+	}
+}
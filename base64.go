@@ -0,0 +1,49 @@
+package fastuuid
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+)
+
+// Base64 returns the unpadded URL-safe base64 encoding
+// of all 24 bytes of uuid, as defined by RFC 4648.
+// The result is always Base64Len characters long.
+func Base64(uuid [24]byte) string {
+	return base64.RawURLEncoding.EncodeToString(uuid[:])
+}
+
+// AppendBase64 appends the Base64 representation of
+// uuid to dst and returns the extended buffer.
+func AppendBase64(dst []byte, uuid [24]byte) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, Base64Len)...)
+	base64.RawURLEncoding.Encode(dst[n:], uuid[:])
+	return dst
+}
+
+// ParseBase64 parses a UUID in the format returned by Base64.
+func ParseBase64(s string) ([24]byte, error) {
+	var uuid [24]byte
+	if len(s) != Base64Len {
+		return uuid, errors.New("invalid base64 UUID " + strconv.Quote(s))
+	}
+	if _, err := base64.RawURLEncoding.Decode(uuid[:], []byte(s)); err != nil {
+		return [24]byte{}, errors.New("invalid base64 UUID " + strconv.Quote(s))
+	}
+	return uuid, nil
+}
+
+// NextBase64Into generates the next UUID from g and writes its
+// Base64 representation to the start of dst, returning the number
+// of bytes written, which is always Base64Len. It does not allocate.
+//
+// It panics if len(dst) < Base64Len.
+func (g *Generator) NextBase64Into(dst []byte) int {
+	if len(dst) < Base64Len {
+		panic("fastuuid: short buffer passed to NextBase64Into")
+	}
+	uuid := g.Next()
+	base64.RawURLEncoding.Encode(dst, uuid[:])
+	return Base64Len
+}
@@ -0,0 +1,140 @@
+package fastuuid
+
+import "testing"
+
+func TestBase64(t *testing.T) {
+	var uuid [24]byte
+	for i := range uuid {
+		uuid[i] = byte(i + 1)
+	}
+	got, want := Base64(uuid), "AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcY"
+	if got != want {
+		t.Fatalf("unexpected Base64 result; got %q want %q", got, want)
+	}
+	parsed, err := ParseBase64(got)
+	if err != nil {
+		t.Fatalf("cannot parse %q: %v", got, err)
+	}
+	if parsed != uuid {
+		t.Fatalf("unexpected ParseBase64 result; got %x want %x", parsed, uuid)
+	}
+}
+
+func TestAppendBase64(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 100; i++ {
+		uuid := g.Next()
+		b := AppendBase64([]byte("x"), uuid)
+		if got, want := string(b), "x"+Base64(uuid); got != want {
+			t.Fatalf("unexpected AppendBase64 result; got %q want %q", got, want)
+		}
+		parsed, err := ParseBase64(string(b[1:]))
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", b[1:], err)
+		}
+		if parsed != uuid {
+			t.Fatalf("round trip mismatch; got %x want %x", parsed, uuid)
+		}
+	}
+}
+
+func TestAppendBase64Allocs(t *testing.T) {
+	uuid := MustNewGenerator().Next()
+	buf := make([]byte, 0, Base64Len)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendBase64(buf[:0], uuid)
+	})
+	if allocs != 0 {
+		t.Fatalf("unexpected allocations; got %v want 0", allocs)
+	}
+}
+
+func BenchmarkAppendBase64(b *testing.B) {
+	g := MustNewGenerator()
+	buf := make([]byte, 0, Base64Len)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendBase64(buf[:0], g.Next())
+	}
+}
+
+var parseBase64ErrorTests = []string{
+	"",
+	"AQIDBAUGBwgJCgsMDQ4PEBESExQVFhc",
+	"AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYA",
+	"AQIDBAUGBwgJCgsMDQ4PEBESExQVFhc=",
+	"AQIDBAUGBwgJCgsMDQ4PEBESExQVFh+/",
+}
+
+func TestParseBase64Error(t *testing.T) {
+	for _, s := range parseBase64ErrorTests {
+		t.Run(s, func(t *testing.T) {
+			if uuid, err := ParseBase64(s); err == nil {
+				t.Fatalf("expected error, got %x", uuid)
+			}
+		})
+	}
+}
+
+func TestNextBase64Into(t *testing.T) {
+	g := MustNewGenerator()
+	first := g.Next()
+	buf := make([]byte, Base64Len+1)
+	for i := 1; i <= 100; i++ {
+		buf[Base64Len] = 'x'
+		n := g.NextBase64Into(buf)
+		if n != Base64Len {
+			t.Fatalf("unexpected byte count; got %d want %d", n, Base64Len)
+		}
+		if buf[Base64Len] != 'x' {
+			t.Fatalf("NextBase64Into wrote past the encoded UUID")
+		}
+		uuid, err := ParseBase64(string(buf[:n]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := ComposeDefault(CounterOf(first)+uint64(i), [16]byte(first[8:])); uuid != want {
+			t.Fatalf("unexpected UUID; got %x want %x", uuid, want)
+		}
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		g.NextBase64Into(buf)
+	})
+	if allocs != 0 {
+		t.Fatalf("unexpected allocation count; got %v want 0", allocs)
+	}
+}
+
+func TestNextBase64IntoShortBuffer(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+	MustNewGenerator().NextBase64Into(make([]byte, Base64Len-1))
+}
+
+func BenchmarkNextBase64Into(b *testing.B) {
+	g := MustNewGenerator()
+	buf := make([]byte, Base64Len)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		g.NextBase64Into(buf)
+	}
+}
+
+func FuzzBase64(f *testing.F) {
+	f.Add("AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcY")
+	for _, s := range parseBase64ErrorTests {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		uuid, err := ParseBase64(s)
+		if err != nil {
+			return
+		}
+		if got := Base64(uuid); got != s {
+			t.Fatalf("round trip mismatch; got %q want %q", got, s)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package fastuuid
+
+import "sync"
+
+// BatchPool lends out slices of UUIDs and recycles them when
+// they are released, reducing garbage collection pressure for
+// code that repeatedly generates batches of UUIDs.
+//
+// The zero value is ready to use. It is OK to use a BatchPool
+// concurrently.
+type BatchPool struct {
+	pool sync.Pool
+}
+
+// Next returns a slice holding the next n UUIDs from g, and a
+// function that returns the slice to the pool. After the release
+// function has been called, the slice may be reused by a later
+// call to Next, so neither it nor any slice aliasing it may be
+// used again; copy out any UUIDs that need to be retained first.
+// The release function must be called at most once.
+func (p *BatchPool) Next(g *Generator, n int) ([]UUID, func()) {
+	bp, _ := p.pool.Get().(*[]UUID)
+	if bp == nil || cap(*bp) < n {
+		b := make([]UUID, n)
+		bp = &b
+	}
+	batch := (*bp)[:n]
+	for i := range batch {
+		batch[i] = g.Next()
+	}
+	return batch, func() {
+		*bp = batch
+		p.pool.Put(bp)
+	}
+}
@@ -0,0 +1,60 @@
+package fastuuid
+
+import "testing"
+
+func TestBatchPool(t *testing.T) {
+	var p BatchPool
+	g := MustNewGenerator()
+	seen := make(map[UUID]bool)
+	for _, n := range []int{0, 1, 10, 5, 100, 3} {
+		batch, release := p.Next(g, n)
+		if len(batch) != n {
+			t.Fatalf("unexpected batch length; got %d want %d", len(batch), n)
+		}
+		for _, uuid := range batch {
+			if seen[uuid] {
+				t.Fatalf("duplicate UUID %x", uuid)
+			}
+			seen[uuid] = true
+		}
+		release()
+	}
+}
+
+func TestBatchPoolReuse(t *testing.T) {
+	var p BatchPool
+	g := MustNewGenerator()
+	allocs := testing.AllocsPerRun(100, func() {
+		_, release := p.Next(g, 64)
+		release()
+	})
+	// One allocation for the release closure; the slice
+	// itself should come from the pool.
+	if allocs > 1 {
+		t.Fatalf("unexpected allocation count; got %v want at most 1", allocs)
+	}
+}
+
+func BenchmarkBatchPool(b *testing.B) {
+	var p BatchPool
+	g := MustNewGenerator()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, release := p.Next(g, 256)
+		release()
+	}
+}
+
+var batchSink []UUID
+
+func BenchmarkBatchAlloc(b *testing.B) {
+	g := MustNewGenerator()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		batch := make([]UUID, 256)
+		for j := range batch {
+			batch[j] = g.Next()
+		}
+		batchSink = batch
+	}
+}
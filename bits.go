@@ -0,0 +1,57 @@
+package fastuuid
+
+import "math/bits"
+
+// HammingDistance returns the number of bits that
+// differ between a and b.
+func HammingDistance(a, b [24]byte) int {
+	n := 0
+	for i := range a {
+		n += bits.OnesCount8(a[i] ^ b[i])
+	}
+	return n
+}
+
+// Complement returns the bitwise complement of uuid.
+// Complementing reverses the order of UUIDs compared as byte
+// strings, so storing complemented keys lets an ascending
+// index scan return the highest UUIDs first. Complement is
+// its own inverse.
+func Complement(uuid [24]byte) [24]byte {
+	for i := range uuid {
+		uuid[i] = ^uuid[i]
+	}
+	return uuid
+}
+
+// LeadingZeroBytes returns the number of zero bytes
+// at the start of uuid.
+func LeadingZeroBytes(uuid [24]byte) int {
+	for i, b := range uuid {
+		if b != 0 {
+			return i
+		}
+	}
+	return len(uuid)
+}
+
+// BinaryString returns the 192 bits of uuid in binary,
+// most significant bit first within each byte, with the
+// bytes separated by spaces, for example
+//
+//	00000001 00000010 ... 00011000
+//
+// This is intended as a debugging aid for examining the
+// layout of the counter and seed.
+func (uuid UUID) BinaryString() string {
+	buf := make([]byte, 0, len(uuid)*9-1)
+	for i, b := range uuid {
+		if i > 0 {
+			buf = append(buf, ' ')
+		}
+		for bit := 7; bit >= 0; bit-- {
+			buf = append(buf, '0'+b>>bit&1)
+		}
+	}
+	return string(buf)
+}
@@ -0,0 +1,111 @@
+package fastuuid
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var hammingDistanceTests = []struct {
+	about string
+	a, b  [24]byte
+	want  int
+}{{
+	about: "identical",
+	a:     [24]byte{1, 2, 3},
+	b:     [24]byte{1, 2, 3},
+	want:  0,
+}, {
+	about: "one bit",
+	a:     [24]byte{23: 0x80},
+	want:  1,
+}, {
+	about: "several bytes",
+	a:     [24]byte{0: 0x0f, 10: 0xff},
+	b:     [24]byte{0: 0xf0, 10: 0xfe},
+	want:  9,
+}, {
+	about: "all bits flipped",
+	a:     [24]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	want:  192,
+}}
+
+func TestHammingDistance(t *testing.T) {
+	for _, test := range hammingDistanceTests {
+		t.Run(test.about, func(t *testing.T) {
+			if got := HammingDistance(test.a, test.b); got != test.want {
+				t.Fatalf("unexpected distance; got %d want %d", got, test.want)
+			}
+			if got := HammingDistance(test.b, test.a); got != test.want {
+				t.Fatalf("unexpected distance with arguments swapped; got %d want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestComplement(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 100; i++ {
+		a, b := g.Next(), g.Next()
+		ca, cb := Complement(a), Complement(b)
+		if Complement(ca) != a {
+			t.Fatalf("Complement is not its own inverse for %x", a)
+		}
+		if HammingDistance(a, ca) != 192 {
+			t.Fatalf("Complement did not flip all bits of %x", a)
+		}
+		if got, want := bytes.Compare(ca[:], cb[:]), -bytes.Compare(a[:], b[:]); got != want {
+			t.Fatalf("Complement did not reverse the order of %x and %x", a, b)
+		}
+	}
+}
+
+var leadingZeroBytesTests = []struct {
+	uuid [24]byte
+	want int
+}{{
+	uuid: [24]byte{1},
+	want: 0,
+}, {
+	uuid: [24]byte{0, 0, 0x80},
+	want: 2,
+}, {
+	uuid: [24]byte{23: 1},
+	want: 23,
+}, {
+	uuid: [24]byte{},
+	want: 24,
+}}
+
+func TestLeadingZeroBytes(t *testing.T) {
+	for _, test := range leadingZeroBytesTests {
+		if got := LeadingZeroBytes(test.uuid); got != test.want {
+			t.Errorf("unexpected LeadingZeroBytes result for %x; got %d want %d", test.uuid, got, test.want)
+		}
+	}
+}
+
+func TestBinaryString(t *testing.T) {
+	uuid := UUID{0: 0x01, 1: 0x80, 2: 0xa5, 23: 0xff}
+	got := uuid.BinaryString()
+	if len(got) != 24*9-1 {
+		t.Fatalf("unexpected length; got %d want %d", len(got), 24*9-1)
+	}
+	groups := strings.Split(got, " ")
+	if len(groups) != 24 {
+		t.Fatalf("unexpected group count; got %d want 24", len(groups))
+	}
+	for i, group := range groups {
+		b, err := strconv.ParseUint(group, 2, 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if byte(b) != uuid[i] {
+			t.Fatalf("unexpected value for byte %d; got %q want %08b", i, group, uuid[i])
+		}
+	}
+	if want := "00000001 10000000 10100101 00000000"; !strings.HasPrefix(got, want) {
+		t.Fatalf("unexpected result; got %q want prefix %q", got, want)
+	}
+}
@@ -0,0 +1,44 @@
+package fastuuid
+
+// Bucket returns a bucket index in the range [0, n) for the given
+// UUID. The index is derived from a hash of all 24 bytes, so
+// sequential UUIDs from a Generator are spread evenly across
+// buckets rather than following the counter.
+//
+// It panics if n <= 0.
+func Bucket(uuid [24]byte, n int) int {
+	if n <= 0 {
+		panic("fastuuid: invalid argument to Bucket")
+	}
+	return int(hash64(uuid) % uint64(n))
+}
+
+// Hash64 returns a 64 bit hash of all 24 bytes of uuid,
+// suitable for use in hash tables. As with Bucket, sequential
+// UUIDs from a Generator have unrelated hashes, so they do not
+// cluster even when only the low bits of the hash are used.
+func (uuid UUID) Hash64() uint64 {
+	return hash64(uuid)
+}
+
+// hash64 returns a 64 bit FNV-1a hash of uuid, passed
+// through a finalizer so that all bits of the result
+// depend on all bits of the input.
+func hash64(uuid [24]byte) uint64 {
+	const (
+		offset64 = 14695981039346656037
+		prime64  = 1099511628211
+	)
+	h := uint64(offset64)
+	for _, b := range uuid {
+		h ^= uint64(b)
+		h *= prime64
+	}
+	// Finalizer from MurmurHash3.
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
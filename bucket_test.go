@@ -0,0 +1,63 @@
+package fastuuid
+
+import "testing"
+
+func TestBucketDistribution(t *testing.T) {
+	g := MustNewGenerator()
+	for _, n := range []int{1, 2, 7, 16, 100} {
+		counts := make([]int, n)
+		const perBucket = 2000
+		for i := 0; i < n*perBucket; i++ {
+			b := Bucket(g.Next(), n)
+			if b < 0 || b >= n {
+				t.Fatalf("bucket %d out of range [0, %d)", b, n)
+			}
+			counts[b]++
+		}
+		for b, count := range counts {
+			if count < perBucket*8/10 || count > perBucket*12/10 {
+				t.Errorf("uneven distribution for n=%d; bucket %d has %d entries, want about %d", n, b, count, perBucket)
+			}
+		}
+	}
+}
+
+func TestBucketDeterministic(t *testing.T) {
+	uuid := MustNewGenerator().Next()
+	if Bucket(uuid, 1000) != Bucket(uuid, 1000) {
+		t.Fatalf("Bucket is not deterministic")
+	}
+}
+
+func TestBucketInvalidN(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+	Bucket([24]byte{}, 0)
+}
+
+func TestHash64Sequential(t *testing.T) {
+	// Place sequential UUIDs into a table with as many slots as
+	// entries, using only the low bits of the hash. A random hash
+	// fills about 1-1/e (63%) of the slots.
+	const size = 1 << 16
+	g := MustNewGenerator()
+	var used [size]bool
+	n := 0
+	for i := 0; i < size; i++ {
+		slot := UUID(g.Next()).Hash64() % size
+		if !used[slot] {
+			used[slot] = true
+			n++
+		}
+	}
+	if n < size*60/100 {
+		t.Fatalf("too many collisions; %d of %d slots used", n, size)
+	}
+	uuid := UUID(g.Next())
+	if uuid.Hash64() != uuid.Hash64() {
+		t.Fatalf("Hash64 is not deterministic")
+	}
+}
@@ -0,0 +1,64 @@
+package fastuuid
+
+import "sync"
+
+// BufferedGenerator hands out UUIDs that have been generated
+// ahead of time by a background goroutine.
+//
+// UUIDs returned by a BufferedGenerator are unique, but there
+// is no guarantee about their order across goroutines.
+type BufferedGenerator struct {
+	g         *Generator
+	c         chan [24]byte
+	closeOnce sync.Once
+	closed    chan struct{}
+	exited    chan struct{}
+}
+
+// NewBufferedGenerator returns a BufferedGenerator that keeps
+// up to buffer UUIDs from g ready for use. The Close
+// method should be called to stop the background goroutine
+// when the generator is no longer needed.
+func NewBufferedGenerator(g *Generator, buffer int) *BufferedGenerator {
+	b := &BufferedGenerator{
+		g:      g,
+		c:      make(chan [24]byte, buffer),
+		closed: make(chan struct{}),
+		exited: make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+func (b *BufferedGenerator) run() {
+	defer close(b.exited)
+	for {
+		select {
+		case b.c <- b.g.Next():
+		case <-b.closed:
+			return
+		}
+	}
+}
+
+// Next returns the next UUID. If no UUIDs are buffered,
+// or the generator has been closed, it generates one directly.
+//
+// It is OK to call this method concurrently.
+func (b *BufferedGenerator) Next() [24]byte {
+	select {
+	case uuid := <-b.c:
+		return uuid
+	default:
+		return b.g.Next()
+	}
+}
+
+// Close stops the background goroutine and waits for it to exit.
+// Any buffered UUIDs remain available from Next.
+func (b *BufferedGenerator) Close() {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+	})
+	<-b.exited
+}
@@ -0,0 +1,57 @@
+package fastuuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestBufferedGeneratorUniqueness(t *testing.T) {
+	b := NewBufferedGenerator(MustNewGenerator(), 64)
+	defer b.Close()
+	const nproc = 4
+	mc := make(chan map[[24]byte]bool)
+	for i := 0; i < nproc; i++ {
+		go func() {
+			m := make(map[[24]byte]bool)
+			for i := 0; i < step; i++ {
+				m[b.Next()] = true
+			}
+			mc <- m
+		}()
+	}
+	m := make(map[[24]byte]bool)
+	for i := 0; i < nproc; i++ {
+		for uuid := range <-mc {
+			if m[uuid] {
+				t.Fatalf("non-unique uuid %x", uuid)
+			}
+			m[uuid] = true
+		}
+	}
+	if len(m) != nproc*step {
+		t.Fatalf("unexpected uuid count; got %d want %d", len(m), nproc*step)
+	}
+}
+
+func TestBufferedGeneratorClose(t *testing.T) {
+	b := NewBufferedGenerator(MustNewGenerator(), 8)
+	b.Next()
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.Close()
+		}()
+	}
+	wg.Wait()
+	select {
+	case <-b.exited:
+	default:
+		t.Fatalf("producer goroutine still running after Close")
+	}
+	// Next still works after Close.
+	if b.Next() == b.Next() {
+		t.Fatalf("non-unique uuids after Close")
+	}
+}
@@ -0,0 +1,74 @@
+package fastuuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync/atomic"
+)
+
+// CachedGenerator is a generator that hands out counter values in
+// blocks to LocalGenerators, each of which is used by a single
+// goroutine. A LocalGenerator makes one atomic add on the shared
+// counter for each block rather than one for each UUID, which
+// reduces contention on the shared counter when many goroutines
+// generate UUIDs at once.
+//
+// UUIDs returned by the LocalGenerators of a CachedGenerator are
+// unique, but unlike Generator, their counters are not issued in
+// strict global order: two goroutines may each be working through
+// a different block. Counter values reserved by a LocalGenerator
+// that is discarded are never issued.
+type CachedGenerator struct {
+	g     *Generator
+	block uint64
+}
+
+// NewCachedGenerator returns a new CachedGenerator with a freshly
+// seeded underlying generator that reserves block counter values at
+// a time. It returns an error if block is not positive.
+func NewCachedGenerator(block int) (*CachedGenerator, error) {
+	if block <= 0 {
+		return nil, errors.New("non-positive block size passed to NewCachedGenerator")
+	}
+	g, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	return &CachedGenerator{
+		g:     g,
+		block: uint64(block),
+	}, nil
+}
+
+// Local returns a new LocalGenerator that reserves
+// its counter values from c.
+//
+// It is OK to call this method concurrently.
+func (c *CachedGenerator) Local() *LocalGenerator {
+	return &LocalGenerator{c: c}
+}
+
+// LocalGenerator generates UUIDs from blocks of counter values
+// reserved from a CachedGenerator. It is intended to be used by
+// a single goroutine: unlike Generator.Next, its Next method must
+// not be called concurrently.
+type LocalGenerator struct {
+	c    *CachedGenerator
+	next uint64
+	left uint64
+}
+
+// Next returns the next UUID from the generator.
+func (l *LocalGenerator) Next() [24]byte {
+	if l.left == 0 {
+		end := atomic.AddUint64(&l.c.g.counter, l.c.block)
+		l.next = end - l.c.block + 1
+		l.left = l.c.block
+	}
+	x := l.next
+	l.next++
+	l.left--
+	uuid := l.c.g.loadSeed()
+	binary.LittleEndian.PutUint64(uuid[:8], x)
+	return uuid
+}
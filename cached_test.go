@@ -0,0 +1,70 @@
+package fastuuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCachedGeneratorUnique(t *testing.T) {
+	c, err := NewCachedGenerator(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const (
+		goroutines = 8
+		perG       = 10000
+	)
+	results := make([][][24]byte, goroutines)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			l := c.Local()
+			uuids := make([][24]byte, perG)
+			for j := range uuids {
+				uuids[j] = l.Next()
+			}
+			results[i] = uuids
+		}(i)
+	}
+	wg.Wait()
+	seed := c.g.seed
+	seen := make(map[[24]byte]bool)
+	for _, uuids := range results {
+		for _, uuid := range uuids {
+			if string(uuid[8:]) != string(seed[8:]) {
+				t.Fatalf("unexpected seed tail; got %x want %x", uuid[8:], seed[8:])
+			}
+			if seen[uuid] {
+				t.Fatalf("duplicate UUID %x", uuid)
+			}
+			seen[uuid] = true
+		}
+	}
+}
+
+func TestNewCachedGeneratorInvalidBlock(t *testing.T) {
+	for _, block := range []int{0, -1} {
+		c, err := NewCachedGenerator(block)
+		if err == nil {
+			t.Fatalf("expected error for block size %d", block)
+		}
+		if c != nil {
+			t.Fatalf("unexpected non-nil generator for block size %d", block)
+		}
+	}
+}
+
+func BenchmarkCachedContended(b *testing.B) {
+	c, err := NewCachedGenerator(64)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.RunParallel(func(pb *testing.PB) {
+		l := c.Local()
+		for pb.Next() {
+			l.Next()
+		}
+	})
+}
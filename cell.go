@@ -0,0 +1,27 @@
+package fastuuid
+
+import "sync/atomic"
+
+// Cell holds a single current UUID that can be
+// replaced atomically. The zero value holds
+// the zero UUID.
+//
+// It is OK to call its methods concurrently.
+type Cell struct {
+	p atomic.Pointer[[24]byte]
+}
+
+// Rotate generates a new UUID from g and stores
+// it as the current value of c.
+func (c *Cell) Rotate(g *Generator) {
+	uuid := g.Next()
+	c.p.Store(&uuid)
+}
+
+// Load returns the current value of c.
+func (c *Cell) Load() [24]byte {
+	if p := c.p.Load(); p != nil {
+		return *p
+	}
+	return [24]byte{}
+}
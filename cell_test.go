@@ -0,0 +1,45 @@
+package fastuuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCell(t *testing.T) {
+	var c Cell
+	if got := c.Load(); got != ([24]byte{}) {
+		t.Fatalf("unexpected initial value; got %x", got)
+	}
+	g := MustNewGenerator()
+	c.Rotate(g)
+	first := c.Load()
+	if first == ([24]byte{}) {
+		t.Fatalf("zero value after Rotate")
+	}
+	c.Rotate(g)
+	if got := c.Load(); got == first {
+		t.Fatalf("value did not change after Rotate")
+	}
+}
+
+func TestCellConcurrent(t *testing.T) {
+	var c Cell
+	g := MustNewGenerator()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Rotate(g)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Load()
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,60 @@
+package fastuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"sync/atomic"
+)
+
+// Clock is a counter that can be shared between several
+// generators, so that the counters of UUIDs from all of them
+// are issued in a single strictly increasing sequence. This is
+// useful when generators are created and discarded frequently
+// but their UUIDs must still be globally ordered by counter.
+//
+// All generators sharing a Clock contend on the same counter,
+// so this centralizes ordering at the cost of scalability.
+type Clock struct {
+	// counter is first so that it is 64-bit aligned
+	// for atomic operations on 32-bit platforms.
+	counter uint64
+}
+
+// NewClock returns a new Clock. The first counter
+// value drawn from it is 1.
+func NewClock() *Clock {
+	return new(Clock)
+}
+
+// ClockGenerator is a UUID generator that draws its counter
+// values from a Clock. Apart from where the counter comes from,
+// its UUIDs have the same layout as those returned by
+// Generator.Next.
+type ClockGenerator struct {
+	clock *Clock
+	seed  [24]byte
+}
+
+// NewGenerator returns a new ClockGenerator with a fresh random
+// seed that draws its counter values from c. It panics if the
+// random seed cannot be generated.
+func (c *Clock) NewGenerator() *ClockGenerator {
+	g := &ClockGenerator{
+		clock: c,
+	}
+	if _, err := rand.Read(g.seed[:]); err != nil {
+		panic(errors.New("cannot generate random seed: " + err.Error()))
+	}
+	return g
+}
+
+// Next returns the next UUID from the generator.
+//
+// It is OK to call this method concurrently.
+func (g *ClockGenerator) Next() [24]byte {
+	x := atomic.AddUint64(&g.clock.counter, 1)
+	uuid := g.seed
+	binary.LittleEndian.PutUint64(uuid[:8], x)
+	return uuid
+}
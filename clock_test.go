@@ -0,0 +1,60 @@
+package fastuuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestClockInterleaved(t *testing.T) {
+	c := NewClock()
+	gens := []*ClockGenerator{c.NewGenerator(), c.NewGenerator(), c.NewGenerator()}
+	var last uint64
+	for i := 0; i < 100; i++ {
+		g := gens[i%len(gens)]
+		uuid := g.Next()
+		got := CounterOf(uuid)
+		if got <= last {
+			t.Fatalf("counter did not increase; got %d after %d", got, last)
+		}
+		last = got
+		if [16]byte(uuid[8:]) != [16]byte(g.seed[8:]) {
+			t.Fatalf("unexpected seed tail; got %x want %x", uuid[8:], g.seed[8:])
+		}
+	}
+	if last != 100 {
+		t.Fatalf("unexpected final counter; got %d want 100", last)
+	}
+	if gens[0].seed == gens[1].seed {
+		t.Fatalf("generators share a seed")
+	}
+}
+
+func TestClockConcurrent(t *testing.T) {
+	c := NewClock()
+	const (
+		goroutines = 8
+		perG       = 1000
+	)
+	var mu sync.Mutex
+	seen := make(map[uint64]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g := c.NewGenerator()
+			for j := 0; j < perG; j++ {
+				x := CounterOf(g.Next())
+				mu.Lock()
+				seen[x] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	for x := uint64(1); x <= goroutines*perG; x++ {
+		if !seen[x] {
+			t.Fatalf("counter %d was not issued", x)
+		}
+	}
+}
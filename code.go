@@ -0,0 +1,104 @@
+package fastuuid
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// maxCodeLen holds the maximum length of a code returned by
+// UUID.Code, excluding the check symbol: the number of whole
+// 5 bit symbols in a UUID.
+const maxCodeLen = RawLen * 8 / 5
+
+// crockfordDigits holds the Crockford base32 digits followed by the
+// five extra symbols used only for the check symbol.
+const crockfordDigits = "0123456789ABCDEFGHJKMNPQRSTVWXYZ*~$=U"
+
+// Code returns a short code for uuid intended to be read and typed
+// by people, such as a coupon code. The code consists of length
+// Crockford base32 digits followed by a check symbol computed as in
+// Crockford's specification: the value of the digits modulo 37. The
+// check symbol detects any single mistyped character and any
+// transposition of adjacent digits.
+//
+// The digits hold the first 5*length bits of uuid taken in order of
+// significance of the little-endian counter held in its first 8
+// bytes: the first digit holds the 5 least significant bits of the
+// counter, the next digit the 5 bits above those, and so on. Codes
+// longer than 12 digits go on to include bits of the seed tail. So
+// for UUIDs returned successively by a Generator counting up by
+// one, Code(length) is distinct for up to 32^length successive
+// UUIDs (for length of at most 12). The codes are derived directly
+// from the counter, so they are predictable; use Generator.NextOpaque
+// when codes must not be guessable.
+//
+// It panics if length is less than 1 or greater than 38.
+func (uuid UUID) Code(length int) string {
+	if length < 1 || length > maxCodeLen {
+		panic("fastuuid: invalid length passed to Code")
+	}
+	buf := make([]byte, length+1)
+	check := 0
+	for i := 0; i < length; i++ {
+		d := 0
+		for j := 0; j < 5; j++ {
+			bit := i*5 + j
+			d |= int(uuid[bit/8]>>(bit%8)&1) << j
+		}
+		buf[i] = crockfordDigits[d]
+		check = (check*32 + d) % 37
+	}
+	buf[length] = crockfordDigits[check]
+	return string(buf)
+}
+
+// ParseCode parses a code in the format returned by UUID.Code,
+// checking its check symbol, and returns a UUID holding the bits
+// from the code in the positions they were taken from by Code, so
+// that a code of n digits (not counting the check symbol) sets the
+// first 5*n bits of the UUID in counter significance order. The
+// remaining bits are zero.
+//
+// As specified by Crockford, the code is case-insensitive, the
+// letters I and L are read as 1, the letter O is read as 0, and
+// hyphens are ignored.
+func ParseCode(s string) (UUID, error) {
+	var uuid UUID
+	code := strings.ReplaceAll(s, "-", "")
+	if len(code) < 2 || len(code) > maxCodeLen+1 {
+		return uuid, errors.New("invalid code " + strconv.Quote(s))
+	}
+	check := 0
+	for i := 0; i < len(code)-1; i++ {
+		d, ok := fromCrockfordChar(code[i])
+		if !ok || d >= 32 {
+			return UUID{}, errors.New("invalid code " + strconv.Quote(s))
+		}
+		for j := 0; j < 5; j++ {
+			if d>>j&1 != 0 {
+				bit := i*5 + j
+				uuid[bit/8] |= 1 << (bit % 8)
+			}
+		}
+		check = (check*32 + d) % 37
+	}
+	if d, ok := fromCrockfordChar(code[len(code)-1]); !ok || d != check {
+		return UUID{}, errors.New("invalid check symbol in code " + strconv.Quote(s))
+	}
+	return uuid, nil
+}
+
+func fromCrockfordChar(c byte) (int, bool) {
+	if 'a' <= c && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	switch c {
+	case 'I', 'L':
+		c = '1'
+	case 'O':
+		c = '0'
+	}
+	i := strings.IndexByte(crockfordDigits, c)
+	return i, i >= 0
+}
@@ -0,0 +1,121 @@
+package fastuuid
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	// Digits are taken from the least significant bits of the
+	// counter upwards, so a counter whose base 32 digits are
+	// 8, 7, ..., 1 from the top down gives the code 12345678.
+	var counter uint64
+	for d := 8; d >= 1; d-- {
+		counter = counter<<5 | uint64(d)
+	}
+	var uuid UUID
+	binary.LittleEndian.PutUint64(uuid[:8], counter)
+	code := uuid.Code(8)
+	if !strings.HasPrefix(code, "12345678") || len(code) != 9 {
+		t.Fatalf("unexpected code; got %q want 12345678 followed by a check symbol", code)
+	}
+	got, err := ParseCode(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != uuid {
+		t.Fatalf("unexpected ParseCode result; got %x want %x", got, uuid)
+	}
+}
+
+func TestCodeRoundTrip(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 1000; i++ {
+		uuid := UUID(g.Next())
+		for _, length := range []int{1, 5, 10, maxCodeLen} {
+			code := uuid.Code(length)
+			if len(code) != length+1 {
+				t.Fatalf("unexpected code length; got %d want %d", len(code), length+1)
+			}
+			got, err := ParseCode(code)
+			if err != nil {
+				t.Fatalf("cannot parse %q: %v", code, err)
+			}
+			if got.Code(length) != code {
+				t.Fatalf("round trip mismatch for %q; got %q", code, got.Code(length))
+			}
+			// Lower case and ambiguous letters are accepted.
+			lower := strings.NewReplacer("1", "l", "0", "o").Replace(strings.ToLower(code))
+			if got1, err := ParseCode(lower[:1] + "-" + lower[1:]); err != nil || got1 != got {
+				t.Fatalf("cannot parse %q as %q: %v", lower, code, err)
+			}
+		}
+	}
+}
+
+func TestCodeSuccessive(t *testing.T) {
+	g := MustNewGenerator()
+	for _, length := range []int{1, 2} {
+		n := 1 << (5 * length)
+		seen := make(map[string]bool)
+		for i := 0; i < n; i++ {
+			code := UUID(g.Next()).Code(length)
+			if seen[code] {
+				t.Fatalf("duplicate code %q after %d successive UUIDs for length %d", code, i, length)
+			}
+			seen[code] = true
+		}
+	}
+}
+
+func TestCodeDetectsErrors(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 100; i++ {
+		code := UUID(g.Next()).Code(10)
+		for pos := 0; pos < len(code); pos++ {
+			// The check symbol can use all the digits; the
+			// others only the first 32.
+			digits := crockfordDigits[:32]
+			if pos == len(code)-1 {
+				digits = crockfordDigits
+			}
+			for j := 0; j < len(digits); j++ {
+				if digits[j] == code[pos] {
+					continue
+				}
+				typo := code[:pos] + digits[j:j+1] + code[pos+1:]
+				if _, err := ParseCode(typo); err == nil {
+					t.Fatalf("typo %q of %q not detected", typo, code)
+				}
+			}
+			if pos > 0 && pos < len(code)-1 && code[pos-1] != code[pos] {
+				swapped := code[:pos-1] + code[pos:pos+1] + code[pos-1:pos] + code[pos+1:]
+				if _, err := ParseCode(swapped); err == nil {
+					t.Fatalf("transposition %q of %q not detected", swapped, code)
+				}
+			}
+		}
+	}
+}
+
+func TestParseCodeError(t *testing.T) {
+	for _, s := range []string{"", "1", "U1", "1U1", strings.Repeat("0", maxCodeLen+2), "1!1"} {
+		if _, err := ParseCode(s); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
+
+func TestCodeInvalidLength(t *testing.T) {
+	for _, length := range []int{0, maxCodeLen + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for length %d", length)
+				}
+			}()
+			UUID{}.Code(length)
+		}()
+	}
+}
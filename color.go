@@ -0,0 +1,16 @@
+package fastuuid
+
+// Color returns a color derived from uuid, suitable for
+// visually distinguishing UUIDs in a user interface.
+//
+// The first 8 bytes, which hold the counter in UUIDs returned
+// by Generator.Next, are ignored, so all UUIDs from the same
+// generator have the same color while UUIDs from different
+// generators usually have different colors.
+func (uuid UUID) Color() (r, g, b uint8) {
+	for i := 0; i < 8; i++ {
+		uuid[i] = 0
+	}
+	h := hash64(uuid)
+	return uint8(h >> 16), uint8(h >> 8), uint8(h)
+}
@@ -0,0 +1,25 @@
+package fastuuid
+
+import "testing"
+
+func TestColor(t *testing.T) {
+	g := MustNewGenerator()
+	uuid := UUID(g.Next())
+	r, gr, b := uuid.Color()
+	for i := 0; i < 10; i++ {
+		r1, g1, b1 := UUID(g.Next()).Color()
+		if r1 != r || g1 != gr || b1 != b {
+			t.Fatalf("UUIDs from the same generator have different colors")
+		}
+	}
+	same := 0
+	for i := 0; i < 100; i++ {
+		r1, g1, b1 := UUID(MustNewGenerator().Next()).Color()
+		if r1 == r && g1 == gr && b1 == b {
+			same++
+		}
+	}
+	if same > 1 {
+		t.Fatalf("too many generators share a color: %d", same)
+	}
+}
@@ -0,0 +1,114 @@
+package fastuuid
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+)
+
+// CompactUUID holds a 128 bit UUID that marshals to and from
+// text as 32 lower case hex digits with no dashes. The bytes
+// are encoded exactly, with none of the rewriting done by Hex128,
+// so the text differs from that returned by HexCompact128 for
+// the same bytes. ParseHexCompact128 recovers the bytes from it.
+//
+// It can be used in place of the dashed form to save space
+// in large documents.
+type CompactUUID [16]byte
+
+// MarshalText implements encoding.TextMarshaler.
+func (u CompactUUID) MarshalText() ([]byte, error) {
+	b := make([]byte, HexCompact128Len)
+	hex.Encode(b, u[:])
+	return b, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts only the compact form produced by MarshalText.
+func (u *CompactUUID) UnmarshalText(data []byte) error {
+	if !ValidHexCompact128(string(data)) {
+		return errors.New("invalid compact UUID " + strconv.Quote(string(data)))
+	}
+	hex.Decode(u[:], data)
+	return nil
+}
+
+// HexCompact128 is like Hex128 but omits the dashes,
+// returning 32 hex digits. Like Hex128, it swaps bytes 6
+// and 9 and sets the version and variant bits, so the result
+// is not the same as the text form of CompactUUID, which
+// encodes the bytes exactly.
+func HexCompact128(uuid [24]byte) string {
+	var buf [HexCompact128Len]byte
+	return string(AppendHexCompact128(buf[:0], uuid))
+}
+
+// AppendHexCompact128 appends the HexCompact128
+// representation of uuid to dst and returns the
+// extended buffer.
+func AppendHexCompact128(dst []byte, uuid [24]byte) []byte {
+	uuid = v4Bytes(uuid)
+	n := len(dst)
+	dst = append(dst, make([]byte, HexCompact128Len)...)
+	hex.Encode(dst[n:], uuid[:16])
+	return dst
+}
+
+// ValidHexCompact128 reports whether s is a valid compact UUID:
+// exactly 32 hex digits with no dashes, as returned both by
+// HexCompact128 and by CompactUUID.MarshalText.
+//
+// Note that it does not allow upper case hex.
+func ValidHexCompact128(s string) bool {
+	return len(s) == HexCompact128Len && isValidHex(s)
+}
+
+// ParseHexCompact128 parses 32 lower case hex digits
+// into the first 16 bytes of a UUID. The digits are decoded
+// in order, making it the inverse of CompactUUID.MarshalText.
+// The remaining bytes are zero.
+//
+// Note that this does not undo the byte swap or the version
+// and variant bits applied by HexCompact128, so the result of
+// parsing HexCompact128(uuid) is not the same as uuid.
+func ParseHexCompact128(s string) ([24]byte, error) {
+	var uuid [24]byte
+	if err := DecodeHexCompact128(&uuid, []byte(s)); err != nil {
+		return [24]byte{}, err
+	}
+	return uuid, nil
+}
+
+// DecodeHexCompact128 is like ParseHexCompact128 but
+// decodes src into *dst, avoiding allocation.
+// On error, *dst is left unchanged.
+func DecodeHexCompact128(dst *[24]byte, src []byte) error {
+	if len(src) != HexCompact128Len {
+		return errors.New("invalid compact UUID " + strconv.Quote(string(src)))
+	}
+	for _, c := range src {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return errors.New("invalid compact UUID " + strconv.Quote(string(src)))
+		}
+	}
+	hex.Decode(dst[:16], src)
+	for i := 16; i < len(dst); i++ {
+		dst[i] = 0
+	}
+	return nil
+}
+
+// SortableString returns the first 16 bytes of uuid as 32 upper
+// case hex digits with no other changes. Because the width is
+// fixed and the digits are in ASCII order, the strings sort in
+// the same order as the bytes, and hence as the big-endian
+// 128 bit values.
+//
+// Note that UUIDs returned by Generator.Next hold their counter in
+// little-endian order, so their strings do not sort in counter
+// order; use UUID.NetworkBytes first if that is needed.
+func (uuid UUID) SortableString() string {
+	var buf [HexCompact128Len]byte
+	encodeHex(buf[:], uuid[:16], hexDigits[1])
+	return string(buf[:])
+}
@@ -0,0 +1,217 @@
+package fastuuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCompactUUIDRoundTrip(t *testing.T) {
+	u := CompactUUID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 0xff}
+	data, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("cannot marshal: %v", err)
+	}
+	if got, want := string(data), `"0102030405060708090a0b0c0d0e0fff"`; got != want {
+		t.Fatalf("unexpected marshaled form; got %s want %s", got, want)
+	}
+	var u1 CompactUUID
+	if err := json.Unmarshal(data, &u1); err != nil {
+		t.Fatalf("cannot unmarshal: %v", err)
+	}
+	if u1 != u {
+		t.Fatalf("unexpected round trip result; got %x want %x", u1, u)
+	}
+}
+
+func TestCompactUUIDParseHexCompact128(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 100; i++ {
+		uuid := g.Next()
+		text, err := CompactUUID(uuid[:16]).MarshalText()
+		if err != nil {
+			t.Fatalf("cannot marshal: %v", err)
+		}
+		got, err := ParseHexCompact128(string(text))
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", text, err)
+		}
+		want := uuid
+		clear(want[16:])
+		if got != want {
+			t.Fatalf("unexpected parsed bytes; got %x want %x", got, want)
+		}
+	}
+}
+
+var compactUUIDUnmarshalErrorTests = []string{
+	"",
+	"0102030405060708090a0b0c0d0e0f",
+	"0102030405060708090a0b0c0d0e0fff00",
+	"01020304-0506-0708-090a-0b0c0d0e0f10",
+	"0102030405060708090A0B0C0D0E0FFF",
+	"0102030405060708090a0b0c0d0e0fzz",
+}
+
+func TestCompactUUIDUnmarshalError(t *testing.T) {
+	for _, s := range compactUUIDUnmarshalErrorTests {
+		t.Run(s, func(t *testing.T) {
+			var u CompactUUID
+			if err := u.UnmarshalText([]byte(s)); err == nil {
+				t.Fatalf("expected error, got %x", u)
+			}
+		})
+	}
+}
+
+func TestHexCompact128(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 100; i++ {
+		uuid := g.Next()
+		s := HexCompact128(uuid)
+		if got, want := s, strings.Replace(Hex128(uuid), "-", "", -1); got != want {
+			t.Fatalf("unexpected HexCompact128 result; got %q want %q", got, want)
+		}
+		if got := string(AppendHexCompact128([]byte("x"), uuid)); got != "x"+s {
+			t.Fatalf("unexpected AppendHexCompact128 result; got %q want %q", got, "x"+s)
+		}
+		parsed, err := ParseHexCompact128(s)
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", s, err)
+		}
+		// The parsed bytes are those encoded by HexCompact128,
+		// which differ from the original UUID.
+		if want := v4Bytes(uuid); [16]byte(parsed[:16]) != [16]byte(want[:16]) {
+			t.Fatalf("unexpected parsed bytes; got %x want %x", parsed[:16], want[:16])
+		}
+		if [8]byte(parsed[16:]) != ([8]byte{}) {
+			t.Fatalf("unexpected non-zero tail %x", parsed[16:])
+		}
+		dst := [24]byte{23: 1}
+		if err := DecodeHexCompact128(&dst, []byte(s)); err != nil {
+			t.Fatalf("cannot decode %q: %v", s, err)
+		}
+		if dst != parsed {
+			t.Fatalf("DecodeHexCompact128 mismatch; got %x want %x", dst, parsed)
+		}
+	}
+}
+
+func TestParseHexCompact128Error(t *testing.T) {
+	for _, s := range compactUUIDUnmarshalErrorTests {
+		t.Run(s, func(t *testing.T) {
+			if uuid, err := ParseHexCompact128(s); err == nil {
+				t.Fatalf("expected error, got %x", uuid)
+			}
+		})
+	}
+}
+
+func TestAppendHexCompact128Allocs(t *testing.T) {
+	uuid := MustNewGenerator().Next()
+	buf := make([]byte, 0, HexCompact128Len)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendHexCompact128(buf[:0], uuid)
+	})
+	if allocs != 0 {
+		t.Fatalf("unexpected allocations; got %v want 0", allocs)
+	}
+	var dst [24]byte
+	allocs = testing.AllocsPerRun(100, func() {
+		DecodeHexCompact128(&dst, buf)
+	})
+	if allocs != 0 {
+		t.Fatalf("unexpected allocations decoding; got %v want 0", allocs)
+	}
+}
+
+func BenchmarkAppendHexCompact128(b *testing.B) {
+	g := MustNewGenerator()
+	buf := make([]byte, 0, HexCompact128Len)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendHexCompact128(buf[:0], g.Next())
+	}
+}
+
+var validHexCompact128Tests = []struct {
+	u     string
+	valid bool
+}{{
+	u:     "0102030405060708090a0b0c0d0e0f10",
+	valid: true,
+}, {
+	u:     "abcdefabcdefabcdefabcdefabcdef00",
+	valid: true,
+}, {
+	u:     "",
+	valid: false,
+}, {
+	u:     "0102030405060708090a0b0c0d0e0f1",
+	valid: false,
+}, {
+	u:     "0102030405060708090a0b0c0d0e0f102",
+	valid: false,
+}, {
+	u:     "01020304-0506-0708-090a-0b0c0d0e0f10",
+	valid: false,
+}, {
+	u:     "0102030405060708090A0B0C0D0E0F10",
+	valid: false,
+}, {
+	u:     "0102030405060708090a0b0c0d0e0fg0",
+	valid: false,
+}, {
+	u:     "0102030405060708090a0b0c0d0e0f1/",
+	valid: false,
+}}
+
+func TestValidHexCompact128(t *testing.T) {
+	for _, test := range validHexCompact128Tests {
+		t.Run(test.u, func(t *testing.T) {
+			if got := ValidHexCompact128(test.u); got != test.valid {
+				t.Fatalf("unexpected valid for %q; got %v want %v", test.u, got, test.valid)
+			}
+		})
+	}
+}
+
+func TestValidHexCompact128Allocs(t *testing.T) {
+	s := HexCompact128(MustNewGenerator().Next())
+	allocs := testing.AllocsPerRun(100, func() {
+		ValidHexCompact128(s)
+	})
+	if allocs != 0 {
+		t.Fatalf("unexpected allocations; got %v want 0", allocs)
+	}
+}
+
+func TestSortableString(t *testing.T) {
+	uuid := UUID{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0xfe, 0xdc, 0xba, 0x98, 0x76, 0x54, 0x32, 0x10, 23: 1}
+	if got, want := uuid.SortableString(), "0123456789ABCDEFFEDCBA9876543210"; got != want {
+		t.Fatalf("unexpected SortableString result; got %q want %q", got, want)
+	}
+	g := MustNewGenerator()
+	uuids := make([]UUID, 1000)
+	for i := range uuids {
+		if i%2 == 0 {
+			uuids[i] = g.Next()
+		} else {
+			uuids[i] = UUID(g.Next()).NetworkBytes()
+		}
+	}
+	byString := append([]UUID(nil), uuids...)
+	sort.Slice(byString, func(i, j int) bool {
+		return byString[i].SortableString() < byString[j].SortableString()
+	})
+	byBytes := append([]UUID(nil), uuids...)
+	sort.Slice(byBytes, func(i, j int) bool {
+		return bytes.Compare(byBytes[i][:16], byBytes[j][:16]) < 0
+	})
+	if !reflect.DeepEqual(byString, byBytes) {
+		t.Fatalf("string order differs from byte order")
+	}
+}
@@ -0,0 +1,72 @@
+package fastuuid
+
+import "encoding/binary"
+
+// CounterOf returns the counter value held in the first 8
+// bytes of a UUID in the layout returned by Generator.Next.
+func CounterOf(uuid [24]byte) uint64 {
+	return binary.LittleEndian.Uint64(uuid[:8])
+}
+
+// ComposeDefault returns the UUID in the layout returned by
+// Generator.Next that has the given counter and seed tail.
+// This can be used to construct the UUID that a generator
+// would return for a given counter value.
+func ComposeDefault(counter uint64, seedTail [16]byte) [24]byte {
+	var uuid [24]byte
+	binary.LittleEndian.PutUint64(uuid[:8], counter)
+	copy(uuid[8:], seedTail[:])
+	return uuid
+}
+
+// IsContiguous reports whether the counter of each UUID,
+// as returned by CounterOf, is exactly one more than the
+// counter of the UUID before it. It returns true if there
+// are fewer than two UUIDs.
+//
+// This is only meaningful for the layout used by Generator.Next.
+func IsContiguous(uuids []UUID) bool {
+	for i := 1; i < len(uuids); i++ {
+		if CounterOf(uuids[i]) != CounterOf(uuids[i-1])+1 {
+			return false
+		}
+	}
+	return true
+}
+
+// SameSeed reports whether a and b have the same seed tail in the
+// layout returned by Generator.Next, which is the case for UUIDs
+// from the same generator unless SetSeedTail has been called.
+func SameSeed(a, b [24]byte) bool {
+	return [16]byte(a[8:]) == [16]byte(b[8:])
+}
+
+// CounterDelta returns the difference between the counters of b and
+// a, in counter units. For a generator that counts up by one, as
+// returned by NewGenerator, this is the number of UUIDs issued after
+// a up to and including b; for a strided or descending generator it
+// must be divided by the generator's step. The difference is not
+// meaningful for UUIDs from a generator returned by
+// NewEscapedGenerator, whose counters are encoded. The boolean result
+// reports whether a and b share a seed, as reported by SameSeed; if
+// they do not, the difference is meaningless.
+//
+// The difference is computed modulo 2^64 and interpreted as a signed
+// value, so it is correct across a counter wraparound and negative
+// when b was issued before a.
+func CounterDelta(a, b [24]byte) (int64, bool) {
+	return int64(CounterOf(b) - CounterOf(a)), SameSeed(a, b)
+}
+
+// AreAdjacent reports whether a and b share a seed and have
+// counters that differ by exactly one in either direction, as is
+// the case for successive UUIDs returned by Generator.Next on a
+// generator that counts up by one. This makes it easy to check the
+// predictability of such UUIDs.
+//
+// Successive UUIDs from a strided generator or from one returned by
+// NewEscapedGenerator are not reported as adjacent.
+func AreAdjacent(a, b [24]byte) bool {
+	delta, ok := CounterDelta(a, b)
+	return ok && (delta == 1 || delta == -1)
+}
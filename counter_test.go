@@ -0,0 +1,96 @@
+package fastuuid
+
+import "testing"
+
+func TestCounterOf(t *testing.T) {
+	g := MustNewGenerator()
+	counter := g.counter
+	for i := uint64(1); i <= 10; i++ {
+		if got, want := CounterOf(g.Next()), counter+i; got != want {
+			t.Fatalf("unexpected counter; got %d want %d", got, want)
+		}
+	}
+}
+
+func TestComposeDefault(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 10; i++ {
+		uuid := g.Next()
+		var tail [16]byte
+		copy(tail[:], uuid[8:])
+		counter := CounterOf(uuid)
+		if got := ComposeDefault(counter, tail); got != uuid {
+			t.Fatalf("unexpected ComposeDefault result; got %x want %x", got, uuid)
+		}
+		if got, want := ComposeDefault(counter+1, tail), g.Next(); got != want {
+			t.Fatalf("unexpected adjacent UUID; got %x want %x", got, want)
+		}
+	}
+}
+
+func TestIsContiguous(t *testing.T) {
+	g := MustNewGenerator()
+	uuids := make([]UUID, 10)
+	for i := range uuids {
+		uuids[i] = g.Next()
+	}
+	if !IsContiguous(uuids) {
+		t.Fatalf("successive UUIDs are not contiguous")
+	}
+	if !IsContiguous(nil) || !IsContiguous(uuids[:1]) {
+		t.Fatalf("short slices are not contiguous")
+	}
+	g.Next()
+	if IsContiguous(append(uuids, g.Next())) {
+		t.Fatalf("UUIDs with a gap are contiguous")
+	}
+	uuids[3], uuids[4] = uuids[4], uuids[3]
+	if IsContiguous(uuids) {
+		t.Fatalf("out of order UUIDs are contiguous")
+	}
+}
+
+func TestCounterDelta(t *testing.T) {
+	g := MustNewGenerator()
+	a := g.Next()
+	for i := 0; i < 99; i++ {
+		g.Next()
+	}
+	b := g.Next()
+	if delta, ok := CounterDelta(a, b); delta != 100 || !ok {
+		t.Fatalf("unexpected delta; got %d, %v want 100, true", delta, ok)
+	}
+	if delta, ok := CounterDelta(b, a); delta != -100 || !ok {
+		t.Fatalf("unexpected reverse delta; got %d, %v want -100, true", delta, ok)
+	}
+	if _, ok := CounterDelta(a, MustNewGenerator().Next()); ok {
+		t.Fatalf("UUIDs from different generators share a seed")
+	}
+	var tail [16]byte
+	if delta, _ := CounterDelta(ComposeDefault(^uint64(0), tail), ComposeDefault(1, tail)); delta != 2 {
+		t.Fatalf("unexpected delta across wraparound; got %d want 2", delta)
+	}
+}
+
+func TestAreAdjacent(t *testing.T) {
+	g := MustNewGenerator()
+	a, b, c := g.Next(), g.Next(), g.Next()
+	if !AreAdjacent(a, b) || !AreAdjacent(b, a) || !AreAdjacent(b, c) {
+		t.Fatalf("successive UUIDs are not adjacent")
+	}
+	if AreAdjacent(a, c) {
+		t.Fatalf("UUIDs two apart are adjacent")
+	}
+	if AreAdjacent(a, a) {
+		t.Fatalf("UUID is adjacent to itself")
+	}
+	d := b
+	d[23] ^= 1
+	if AreAdjacent(a, d) {
+		t.Fatalf("UUIDs with different seeds are adjacent")
+	}
+	var tail [16]byte
+	if !AreAdjacent(ComposeDefault(^uint64(0), tail), ComposeDefault(0, tail)) {
+		t.Fatalf("UUIDs across the counter wraparound are not adjacent")
+	}
+}
@@ -0,0 +1,32 @@
+package fastuuid
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// NextWithCRC is like Next except that bytes 12 to 16 of the UUID
+// hold the IEEE CRC-32 checksum of payload in little-endian order,
+// tying the UUID to the data it identifies so that a mismatch
+// between the two can be detected with CRCOf. These bytes are
+// preserved by Hex128, and they do not overlap the worker ID
+// stored by NewWorkerGenerator or the tag stored by NextTagged.
+//
+// The checksum replaces four random bytes of the seed tail, so
+// the random part of the UUID is reduced from 128 to 96 bits.
+// Note that a CRC detects accidental corruption only: anyone can
+// compute a matching checksum for different data.
+//
+// It is OK to call this method concurrently.
+func (g *Generator) NextWithCRC(payload []byte) [24]byte {
+	uuid := g.Next()
+	binary.LittleEndian.PutUint32(uuid[12:16], crc32.ChecksumIEEE(payload))
+	return uuid
+}
+
+// CRCOf returns the checksum held in a UUID returned by
+// Generator.NextWithCRC. The result is meaningless
+// for other UUIDs.
+func CRCOf(uuid [24]byte) uint32 {
+	return binary.LittleEndian.Uint32(uuid[12:16])
+}
@@ -0,0 +1,33 @@
+package fastuuid
+
+import (
+	"hash/crc32"
+	"strconv"
+	"testing"
+)
+
+func TestNextWithCRC(t *testing.T) {
+	g := MustNewGenerator()
+	seen := make(map[[24]byte]bool)
+	for i := 0; i < 1000; i++ {
+		payload := []byte("payload " + strconv.Itoa(i))
+		uuid := g.NextWithCRC(payload)
+		if got, want := CRCOf(uuid), crc32.ChecksumIEEE(payload); got != want {
+			t.Fatalf("unexpected CRC; got %#x want %#x", got, want)
+		}
+		if seen[uuid] {
+			t.Fatalf("duplicate UUID %x", uuid)
+		}
+		seen[uuid] = true
+		parsed, err := ParseHex128(Hex128(uuid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := CRCOf(parsed), crc32.ChecksumIEEE(payload); got != want {
+			t.Fatalf("CRC not preserved by Hex128; got %#x want %#x", got, want)
+		}
+	}
+	if CRCOf(g.NextWithCRC([]byte("a"))) == CRCOf(g.NextWithCRC([]byte("b"))) {
+		t.Fatalf("different payloads have the same CRC")
+	}
+}
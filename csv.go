@@ -0,0 +1,27 @@
+package fastuuid
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes count newly generated UUIDs to w as CSV
+// records of the form index,hex128,base64, where index
+// counts from zero, hex128 is as returned by Hex128 and
+// base64 is as returned by Base64. No header row is written.
+func (g *Generator) WriteCSV(w io.Writer, count int) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, 3)
+	for i := 0; i < count; i++ {
+		uuid := g.Next()
+		record[0] = strconv.Itoa(i)
+		record[1] = Hex128(uuid)
+		record[2] = Base64(uuid)
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,62 @@
+package fastuuid
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	g := MustNewGenerator()
+	var buf bytes.Buffer
+	const count = 1000
+	if err := g.WriteCSV(&buf, count); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != count {
+		t.Fatalf("unexpected record count; got %d want %d", len(records), count)
+	}
+	seen := make(map[string]bool)
+	for i, record := range records {
+		if len(record) != 3 {
+			t.Fatalf("unexpected field count in record %d; got %d want 3", i, len(record))
+		}
+		if record[0] != strconv.Itoa(i) {
+			t.Fatalf("unexpected index; got %q want %d", record[0], i)
+		}
+		if !ValidHex128(record[1]) {
+			t.Fatalf("invalid hex128 field %q", record[1])
+		}
+		uuid, err := ParseBase64(record[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := Hex128(uuid); got != record[1] {
+			t.Fatalf("hex128 and base64 fields disagree; got %q want %q", got, record[1])
+		}
+		if seen[record[1]] {
+			t.Fatalf("duplicate UUID %q", record[1])
+		}
+		seen[record[1]] = true
+	}
+}
+
+type errorWriter struct{}
+
+func (errorWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriteCSVError(t *testing.T) {
+	g := MustNewGenerator()
+	err := g.WriteCSV(errorWriter{}, 10)
+	if err == nil || err.Error() != "write failed" {
+		t.Fatalf("unexpected error; got %v want write failed", err)
+	}
+}
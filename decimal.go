@@ -0,0 +1,42 @@
+package fastuuid
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// decimal128Len holds the number of decimal digits
+// needed to represent any 128 bit value.
+const decimal128Len = 39
+
+// Decimal128 returns the first 16 bytes of uuid interpreted
+// as a big-endian unsigned integer, formatted in decimal
+// and zero-padded to 39 digits. Because the width is fixed,
+// the strings sort in the same order as the numbers.
+func Decimal128(uuid [24]byte) string {
+	s := new(big.Int).SetBytes(uuid[:16]).String()
+	return strings.Repeat("0", decimal128Len-len(s)) + s
+}
+
+// ParseDecimal128 parses a string in the format returned
+// by Decimal128 into the first 16 bytes of a UUID.
+// The remaining bytes are zero.
+func ParseDecimal128(s string) ([24]byte, error) {
+	var uuid [24]byte
+	if len(s) != decimal128Len {
+		return uuid, errors.New("invalid decimal UUID " + strconv.Quote(s))
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return uuid, errors.New("invalid decimal UUID " + strconv.Quote(s))
+		}
+	}
+	x, _ := new(big.Int).SetString(s, 10)
+	if x.BitLen() > 128 {
+		return uuid, errors.New("decimal UUID " + strconv.Quote(s) + " out of range")
+	}
+	x.FillBytes(uuid[:16])
+	return uuid, nil
+}
@@ -0,0 +1,64 @@
+package fastuuid
+
+import "testing"
+
+var decimal128Tests = []struct {
+	about string
+	uuid  [24]byte
+	want  string
+}{{
+	about: "zero",
+	want:  "000000000000000000000000000000000000000",
+}, {
+	about: "one",
+	uuid:  [24]byte{15: 1},
+	want:  "000000000000000000000000000000000000001",
+}, {
+	about: "all ones",
+	uuid:  [24]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	want:  "340282366920938463463374607431768211455",
+}, {
+	about: "trailing bytes ignored",
+	uuid:  [24]byte{15: 10, 16: 0xff, 23: 0xff},
+	want:  "000000000000000000000000000000000000010",
+}}
+
+func TestDecimal128(t *testing.T) {
+	for _, test := range decimal128Tests {
+		t.Run(test.about, func(t *testing.T) {
+			got := Decimal128(test.uuid)
+			if got != test.want {
+				t.Fatalf("unexpected Decimal128 result; got %q want %q", got, test.want)
+			}
+			uuid, err := ParseDecimal128(got)
+			if err != nil {
+				t.Fatalf("cannot parse %q: %v", got, err)
+			}
+			var want [24]byte
+			copy(want[:16], test.uuid[:16])
+			if uuid != want {
+				t.Fatalf("unexpected ParseDecimal128 result; got %x want %x", uuid, want)
+			}
+		})
+	}
+}
+
+var parseDecimal128ErrorTests = []string{
+	"",
+	"00000000000000000000000000000000000001",
+	"0000000000000000000000000000000000000001",
+	"00000000000000000000000000000000000000x",
+	"-00000000000000000000000000000000000001",
+	"340282366920938463463374607431768211456",
+	"999999999999999999999999999999999999999",
+}
+
+func TestParseDecimal128Error(t *testing.T) {
+	for _, s := range parseDecimal128ErrorTests {
+		t.Run(s, func(t *testing.T) {
+			if uuid, err := ParseDecimal128(s); err == nil {
+				t.Fatalf("expected error, got %x", uuid)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package fastuuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Derive returns a UUID derived from the parent UUID and
+// the given index, for example to identify a child
+// entity of parent. The result is the first 24 bytes of a
+// SHA-256 hash of parent and index, so it is deterministic
+// and distinct (parent, index) pairs are vanishingly
+// unlikely to produce the same UUID.
+//
+// Unlike the UUIDs returned by Generator.Next, derived UUIDs
+// for successive indexes are not adjacent to one another.
+func Derive(parent [24]byte, index uint64) [24]byte {
+	var buf [24 + 8]byte
+	copy(buf[:24], parent[:])
+	binary.LittleEndian.PutUint64(buf[24:], index)
+	sum := sha256.Sum256(buf[:])
+	var uuid [24]byte
+	copy(uuid[:], sum[:])
+	return uuid
+}
+
+// Combine returns a UUID derived from both a and b, for
+// example so that two parties can each contribute randomness
+// to a shared identifier without either one controlling the
+// result. The result is the first 24 bytes of a SHA-256 hash
+// of a followed by b, so it is deterministic and depends on
+// the order of its arguments.
+//
+// Combine is not intended for sequential generation: the
+// combined UUIDs of adjacent inputs are not adjacent.
+func Combine(a, b [24]byte) [24]byte {
+	var buf [48]byte
+	copy(buf[:24], a[:])
+	copy(buf[24:], b[:])
+	sum := sha256.Sum256(buf[:])
+	var uuid [24]byte
+	copy(uuid[:], sum[:])
+	return uuid
+}
+
+// DeriveFromBytes returns a UUID derived deterministically from
+// data, for example to make an idempotency key from the content
+// of a request: the same data always produces the same UUID,
+// independently of any Generator. The result is the first 24
+// bytes of a SHA-256 hash of data, so distinct inputs are
+// vanishingly unlikely to produce the same UUID.
+//
+// To mark the result as name-based rather than random, byte 6
+// holds the RFC 9562 version 8 (custom) nibble and byte 8 holds
+// the RFC 4122 variant bits, so that Hex128Raw of the result is a
+// valid version 8 UUID. This is similar to a version 5 UUID, but
+// uses SHA-256 over the raw bytes with no namespace.
+func DeriveFromBytes(data []byte) [24]byte {
+	sum := sha256.Sum256(data)
+	var uuid [24]byte
+	copy(uuid[:], sum[:])
+	uuid[6] = uuid[6]&0x0f | 0x80
+	uuid[8] = uuid[8]&0x3f | 0x80
+	return uuid
+}
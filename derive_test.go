@@ -0,0 +1,74 @@
+package fastuuid
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+)
+
+func TestDerive(t *testing.T) {
+	g := MustNewGenerator()
+	parent := g.Next()
+	m := make(map[[24]byte]uint64)
+	for i := uint64(0); i < 1000; i++ {
+		child := Derive(parent, i)
+		if child != Derive(parent, i) {
+			t.Fatalf("Derive is not deterministic for index %d", i)
+		}
+		if old, ok := m[child]; ok {
+			t.Fatalf("index %d derives the same UUID as index %d", i, old)
+		}
+		m[child] = i
+	}
+	if Derive(g.Next(), 0) == Derive(parent, 0) {
+		t.Fatalf("different parents derive the same UUID")
+	}
+}
+
+func TestCombine(t *testing.T) {
+	a, b := MustNewGenerator().Next(), MustNewGenerator().Next()
+	c := Combine(a, b)
+	if Combine(a, b) != c {
+		t.Fatalf("Combine is not deterministic")
+	}
+	if Combine(b, a) == c {
+		t.Fatalf("Combine does not depend on argument order")
+	}
+	if c == a || c == b {
+		t.Fatalf("Combine returned one of its arguments")
+	}
+	b[23] ^= 1
+	if Combine(a, b) == c {
+		t.Fatalf("Combine does not depend on all of its second argument")
+	}
+}
+
+func TestDeriveFromBytes(t *testing.T) {
+	uuid := DeriveFromBytes([]byte("hello"))
+	if DeriveFromBytes([]byte("hello")) != uuid {
+		t.Fatalf("DeriveFromBytes is not deterministic")
+	}
+	sum := sha256.Sum256([]byte("hello"))
+	if !bytes.Equal(uuid[9:], sum[9:24]) || uuid[7] != sum[7] {
+		t.Fatalf("unexpected DeriveFromBytes result; got %x want prefix of %x", uuid, sum)
+	}
+	s := Hex128Raw(uuid)
+	if s[14] != '8' {
+		t.Fatalf("unexpected version in %q", s)
+	}
+	if v := s[19]; v != '8' && v != '9' && v != 'a' && v != 'b' {
+		t.Fatalf("unexpected variant in %q", s)
+	}
+	seen := make(map[[24]byte]bool)
+	for i := 0; i < 10000; i++ {
+		uuid := DeriveFromBytes([]byte(strconv.Itoa(i)))
+		if seen[uuid] {
+			t.Fatalf("duplicate UUID %x", uuid)
+		}
+		seen[uuid] = true
+	}
+	if DeriveFromBytes(nil) != DeriveFromBytes([]byte{}) {
+		t.Fatalf("nil and empty data produce different UUIDs")
+	}
+}
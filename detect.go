@@ -0,0 +1,89 @@
+package fastuuid
+
+// Encoding identifies one of the string encodings
+// supported by this package.
+type Encoding int
+
+const (
+	// EncodingUnknown is returned by DetectEncoding
+	// when no encoding matches.
+	EncodingUnknown Encoding = iota
+
+	// EncodingHex128 is the form returned by Hex128,
+	// parsed case-insensitively by ParseHex128CI.
+	EncodingHex128
+
+	// EncodingHexCompact128 is the form returned by
+	// HexCompact128 and parsed by ParseHexCompact128.
+	// Like EncodingHex128, it is detected regardless of
+	// case, so it also covers UUID.SortableString.
+	EncodingHexCompact128
+
+	// EncodingBase64 is the form returned by Base64
+	// and parsed by ParseBase64.
+	EncodingBase64
+
+	// EncodingBase32 is the form returned by Base32_128
+	// and parsed by ParseBase32_128.
+	EncodingBase32
+
+	// EncodingDecimal128 is the form returned by Decimal128
+	// and parsed by ParseDecimal128.
+	EncodingDecimal128
+
+	// EncodingBase62 is the form returned by Base62_128
+	// and parsed by ParseBase62_128.
+	EncodingBase62
+)
+
+// DetectEncoding returns the encoding that s is most likely to be
+// in, judging by its length and alphabet. The boolean result
+// reports whether the detection is confident, which is the case
+// when s is valid in exactly one encoding.
+//
+// Some strings are valid in more than one encoding: for example
+// any 32 digit hex string is also valid base64. In
+// that case, the more restrictive encoding is returned, with
+// a false boolean. If s is valid in no encoding,
+// DetectEncoding returns EncodingUnknown, false.
+func DetectEncoding(s string) (Encoding, bool) {
+	var candidates []Encoding
+	for _, e := range [...]struct {
+		enc   Encoding
+		valid func(string) bool
+	}{
+		// Most restrictive first.
+		{EncodingDecimal128, func(s string) bool {
+			_, err := ParseDecimal128(s)
+			return err == nil
+		}},
+		{EncodingHex128, func(s string) bool {
+			_, err := ParseHex128CI(s)
+			return err == nil
+		}},
+		{EncodingHexCompact128, func(s string) bool {
+			var buf [HexCompact128Len / 2]byte
+			return len(s) == HexCompact128Len && decodeHexCI(buf[:], s)
+		}},
+		{EncodingBase62, func(s string) bool {
+			_, err := ParseBase62_128(s)
+			return err == nil
+		}},
+		{EncodingBase32, func(s string) bool {
+			_, err := ParseBase32_128(s)
+			return err == nil
+		}},
+		{EncodingBase64, func(s string) bool {
+			_, err := ParseBase64(s)
+			return err == nil
+		}},
+	} {
+		if e.valid(s) {
+			candidates = append(candidates, e.enc)
+		}
+	}
+	if len(candidates) == 0 {
+		return EncodingUnknown, false
+	}
+	return candidates[0], len(candidates) == 1
+}
@@ -0,0 +1,96 @@
+package fastuuid
+
+import "testing"
+
+var detectEncodingTests = []struct {
+	about     string
+	s         string
+	want      Encoding
+	confident bool
+}{{
+	about:     "hex128",
+	s:         "c3b32e5e-1e3d-4a7f-8c9b-0d1e2f3a4b5c",
+	want:      EncodingHex128,
+	confident: true,
+}, {
+	about:     "upper case hex128",
+	s:         "C3B32E5E-1E3D-4A7F-8C9B-0D1E2F3A4B5C",
+	want:      EncodingHex128,
+	confident: true,
+}, {
+	about:     "compact hex is also valid base64",
+	s:         "c3b32e5e1e3d4a7f8c9b0d1e2f3a4b5c",
+	want:      EncodingHexCompact128,
+	confident: false,
+}, {
+	about:     "upper case compact hex is also valid base64",
+	s:         "C3B32E5E1E3D4A7F8C9B0D1E2F3A4B5C",
+	want:      EncodingHexCompact128,
+	confident: false,
+}, {
+	about:     "base64",
+	s:         "w7Muqh49Sn-Mmw0eLzpLXAECAwQFBgcI",
+	want:      EncodingBase64,
+	confident: true,
+}, {
+	about:     "base32",
+	s:         "yozs4xq6hvfh7de3bupc6ouvly",
+	want:      EncodingBase32,
+	confident: true,
+}, {
+	about:     "decimal",
+	s:         "259903493728806779828176955054635220828",
+	want:      EncodingDecimal128,
+	confident: true,
+}, {
+	about:     "base62",
+	s:         "01tuWckR0Qgud2DqqiTysq",
+	want:      EncodingBase62,
+	confident: true,
+}, {
+	about: "empty",
+	s:     "",
+	want:  EncodingUnknown,
+}, {
+	about: "wrong length",
+	s:     "c3b32e5e",
+	want:  EncodingUnknown,
+}, {
+	about: "invalid characters",
+	s:     "c3b32e5e-1e3d-4a7f-8c9b-0d1e2f3a4b5!",
+	want:  EncodingUnknown,
+}}
+
+func TestDetectEncoding(t *testing.T) {
+	for _, test := range detectEncodingTests {
+		t.Run(test.about, func(t *testing.T) {
+			got, confident := DetectEncoding(test.s)
+			if got != test.want || confident != test.confident {
+				t.Fatalf("unexpected result; got %v, %v want %v, %v", got, confident, test.want, test.confident)
+			}
+		})
+	}
+}
+
+func TestDetectEncodingGenerated(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 100; i++ {
+		uuid := g.Next()
+		for _, test := range []struct {
+			s    string
+			want Encoding
+		}{
+			{Hex128(uuid), EncodingHex128},
+			{HexCompact128(uuid), EncodingHexCompact128},
+			{UUID(uuid).SortableString(), EncodingHexCompact128},
+			{Base64(uuid), EncodingBase64},
+			{Base32_128(uuid), EncodingBase32},
+			{Decimal128(uuid), EncodingDecimal128},
+			{Base62_128(uuid), EncodingBase62},
+		} {
+			if got, _ := DetectEncoding(test.s); got != test.want {
+				t.Fatalf("unexpected encoding for %q; got %v want %v", test.s, got, test.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package fastuuid
+
+import "math"
+
+// EntropyBits returns a heuristic estimate of the information
+// content of uuid in bits: the Shannon entropy of the
+// distribution of its byte values, multiplied by the number of
+// bytes. An all-zero UUID scores 0; a UUID with 24 distinct
+// bytes scores the maximum of 24*log2(24), about 110.
+//
+// This looks at a single value only, so it can flag UUIDs that
+// are obviously not random, such as those derived from a zero
+// seed, but it is not a measure of the entropy of the source
+// that produced them.
+func (uuid UUID) EntropyBits() float64 {
+	return entropyBits(uuid[:])
+}
+
+// SeedEntropyBits returns a heuristic estimate of the information
+// content in bits of the random part of g's seed, bytes 8 to 24,
+// computed in the same way as UUID.EntropyBits. The maximum is
+// 16*log2(16) = 64. A seed read from a working random source
+// almost always scores above 50; a score far below that suggests
+// a broken source, so this can be used in a health check.
+//
+// Like UUID.EntropyBits, this looks at a single value only and is
+// not a true measure of the entropy of the random source.
+func (g *Generator) SeedEntropyBits() float64 {
+	seed := g.loadSeed()
+	return entropyBits(seed[8:])
+}
+
+// entropyBits returns the Shannon entropy of the distribution
+// of byte values in b, multiplied by len(b).
+func entropyBits(b []byte) float64 {
+	var counts [256]int
+	for _, c := range b {
+		counts[c]++
+	}
+	h := 0.0
+	for _, n := range counts {
+		if n == 0 {
+			continue
+		}
+		p := float64(n) / float64(len(b))
+		h -= p * math.Log2(p)
+	}
+	return h * float64(len(b))
+}
@@ -0,0 +1,73 @@
+package fastuuid
+
+import (
+	"math"
+	"testing"
+)
+
+var entropyBitsTests = []struct {
+	about string
+	uuid  UUID
+	want  float64
+}{{
+	about: "all zero",
+	uuid:  UUID{},
+	want:  0,
+}, {
+	about: "two equally common values",
+	uuid:  UUID{0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1},
+	want:  24,
+}, {
+	about: "all distinct",
+	uuid:  UUID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24},
+	want:  24 * math.Log2(24),
+}}
+
+func TestEntropyBits(t *testing.T) {
+	for _, test := range entropyBitsTests {
+		t.Run(test.about, func(t *testing.T) {
+			if got := test.uuid.EntropyBits(); math.Abs(got-test.want) > 1e-9 {
+				t.Fatalf("unexpected entropy; got %v want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEntropyBitsRandom(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 1000; i++ {
+		uuid := UUID(g.Next())
+		// Random UUIDs score above 90 in all but a vanishingly
+		// small fraction of cases.
+		if got := uuid.EntropyBits(); got < 80 {
+			t.Fatalf("unexpectedly low entropy for %x; got %v", uuid, got)
+		}
+	}
+}
+
+func TestSeedEntropyBits(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		g := MustNewGenerator()
+		// A random 16 byte seed scores above 50 in all but
+		// a vanishingly small fraction of cases.
+		if got := g.SeedEntropyBits(); got < 40 {
+			t.Fatalf("unexpectedly low seed entropy; got %v", got)
+		}
+	}
+	g := MustNewGenerator()
+	var tail [16]byte
+	for i := range tail {
+		tail[i] = 0x5a
+	}
+	g.SetSeedTail(tail)
+	if got := g.SeedEntropyBits(); got != 0 {
+		t.Fatalf("unexpected entropy for constant seed; got %v want 0", got)
+	}
+	for i := range tail {
+		tail[i] = byte(i)
+	}
+	g.SetSeedTail(tail)
+	if got, want := g.SeedEntropyBits(), 64.0; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("unexpected entropy for distinct seed bytes; got %v want %v", got, want)
+	}
+}
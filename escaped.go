@@ -0,0 +1,103 @@
+package fastuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"sync/atomic"
+)
+
+// escapedCounterValues holds the number of distinct counter
+// encodings available to an escaped generator: each of the
+// 8 counter bytes can take one of 255 values.
+const escapedCounterValues = 255 * 255 * 255 * 255 * 255 * 255 * 255 * 255
+
+// EscapedGenerator is a UUID generator whose UUIDs never
+// contain a given forbidden byte in any position. This is useful
+// when UUIDs are embedded in a format that uses the forbidden
+// byte as a delimiter.
+//
+// The random seed tail is chosen so that it does not contain
+// the forbidden byte, and the counter is stored in the first 8
+// bytes as 8 little-endian base-255 digits, each skipping the
+// forbidden byte. This reduces the value space: the counter has
+// 255^8 (about 1.8e19) distinct encodings rather than 2^64, and
+// UUIDs are unique for that many calls. The seed tail has 255^16
+// possible values rather than 256^16.
+//
+// Because the counter is no longer stored as a plain integer,
+// CounterOf and related functions do not apply to these UUIDs.
+//
+// EscapedGenerator provides only the methods that keep the
+// guarantee; the other ways of generating UUIDs provided by
+// Generator, such as NextTagged and NextOpaque, would break it.
+type EscapedGenerator struct {
+	// counter is first so that it is 64-bit aligned
+	// for atomic operations on 32-bit platforms.
+	counter   uint64
+	seed      [24]byte
+	forbidden byte
+}
+
+// NewEscapedGenerator returns a new EscapedGenerator whose UUIDs
+// never contain the byte forbidden.
+// It can fail if the crypto/rand read fails.
+func NewEscapedGenerator(forbidden byte) (*EscapedGenerator, error) {
+	g := &EscapedGenerator{
+		forbidden: forbidden,
+	}
+	if _, err := rand.Read(g.seed[:]); err != nil {
+		return nil, errors.New("cannot generate random seed: " + err.Error())
+	}
+	for i := 8; i < len(g.seed); i++ {
+		for g.seed[i] == forbidden {
+			if _, err := rand.Read(g.seed[i : i+1]); err != nil {
+				return nil, errors.New("cannot generate random seed: " + err.Error())
+			}
+		}
+	}
+	// Start low enough that the counter cannot wrap
+	// before all the encodings have been used.
+	g.counter = binary.LittleEndian.Uint64(g.seed[:8]) % (1<<64 - escapedCounterValues)
+	return g, nil
+}
+
+// Next returns the next UUID from the generator.
+//
+// It is OK to call this method concurrently.
+func (g *EscapedGenerator) Next() [24]byte {
+	x := atomic.AddUint64(&g.counter, 1)
+	uuid := g.seed
+	putEscapedCounter(uuid[:8], x, g.forbidden)
+	return uuid
+}
+
+// NextWithPrev is like Generator.NextWithPrev: it returns the next
+// UUID and the UUID immediately preceding it in the generator's
+// sequence. Neither contains the forbidden byte.
+//
+// It is OK to call this method concurrently.
+func (g *EscapedGenerator) NextWithPrev() (cur, prev [24]byte) {
+	x := atomic.AddUint64(&g.counter, 1)
+	cur = g.seed
+	prev = cur
+	putEscapedCounter(cur[:8], x, g.forbidden)
+	putEscapedCounter(prev[:8], x-1, g.forbidden)
+	return cur, prev
+}
+
+// putEscapedCounter stores x modulo escapedCounterValues into
+// the 8 bytes of dst as little-endian base-255 digits, with
+// digits at or above forbidden shifted up by one so that
+// forbidden never appears.
+func putEscapedCounter(dst []byte, x uint64, forbidden byte) {
+	x %= escapedCounterValues
+	for i := range dst[:8] {
+		d := byte(x % 255)
+		if d >= forbidden {
+			d++
+		}
+		dst[i] = d
+		x /= 255
+	}
+}
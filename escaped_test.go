@@ -0,0 +1,62 @@
+package fastuuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEscapedGenerator(t *testing.T) {
+	for _, forbidden := range []byte{0x00, 0x7f, 0xfe, 0xff} {
+		g, err := NewEscapedGenerator(forbidden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen := make(map[[24]byte]bool)
+		for i := 0; i < 100000; i++ {
+			uuid := g.Next()
+			if bytes.IndexByte(uuid[:], forbidden) >= 0 {
+				t.Fatalf("UUID %x contains forbidden byte %#x", uuid, forbidden)
+			}
+			if seen[uuid] {
+				t.Fatalf("duplicate UUID %x", uuid)
+			}
+			seen[uuid] = true
+		}
+	}
+}
+
+func TestEscapedGeneratorNextWithPrev(t *testing.T) {
+	g, err := NewEscapedGenerator(0xff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev, _ := g.NextWithPrev()
+	for i := 0; i < 1000; i++ {
+		cur, p := g.NextWithPrev()
+		if p != prev {
+			t.Fatalf("unexpected previous UUID; got %x want %x", p, prev)
+		}
+		if bytes.IndexByte(cur[:], 0xff) >= 0 {
+			t.Fatalf("UUID %x contains forbidden byte", cur)
+		}
+		prev = cur
+	}
+}
+
+func TestPutEscapedCounter(t *testing.T) {
+	var buf [8]byte
+	// With the counter digits all at their maximum,
+	// nothing should carry into a forbidden digit.
+	putEscapedCounter(buf[:], escapedCounterValues-1, 0xff)
+	if want := [8]byte{0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe}; buf != want {
+		t.Fatalf("unexpected encoding; got %x want %x", buf, want)
+	}
+	putEscapedCounter(buf[:], 0, 0)
+	if want := [8]byte{1, 1, 1, 1, 1, 1, 1, 1}; buf != want {
+		t.Fatalf("unexpected encoding; got %x want %x", buf, want)
+	}
+	putEscapedCounter(buf[:], 255, 0)
+	if want := [8]byte{1, 2, 1, 1, 1, 1, 1, 1}; buf != want {
+		t.Fatalf("unexpected encoding; got %x want %x", buf, want)
+	}
+}
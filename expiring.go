@@ -0,0 +1,48 @@
+package fastuuid
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrExpired is returned by ExpiringGenerator.Next
+// when the generator's lifetime has elapsed.
+var ErrExpired = errors.New("generator has expired")
+
+// ExpiringGenerator is a generator that stops generating
+// UUIDs after a fixed lifetime, which can be used to
+// enforce regular rotation of generators.
+type ExpiringGenerator struct {
+	g      *Generator
+	expiry time.Time
+
+	// now is used to read the current time.
+	// It is replaced in tests.
+	now func() time.Time
+}
+
+// NewExpiringGenerator returns a new ExpiringGenerator that
+// generates UUIDs until ttl has elapsed.
+// It can fail if the crypto/rand read fails.
+func NewExpiringGenerator(ttl time.Duration) (*ExpiringGenerator, error) {
+	g, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	return &ExpiringGenerator{
+		g:      g,
+		expiry: time.Now().Add(ttl),
+		now:    time.Now,
+	}, nil
+}
+
+// Next returns the next UUID from the generator,
+// or ErrExpired if the generator has expired.
+//
+// It is OK to call this method concurrently.
+func (e *ExpiringGenerator) Next() ([24]byte, error) {
+	if !e.now().Before(e.expiry) {
+		return [24]byte{}, ErrExpired
+	}
+	return e.g.Next(), nil
+}
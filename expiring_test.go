@@ -0,0 +1,36 @@
+package fastuuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpiringGenerator(t *testing.T) {
+	e, err := NewExpiringGenerator(time.Minute)
+	if err != nil {
+		t.Fatalf("cannot make generator: %v", err)
+	}
+	now := time.Now()
+	e.now = func() time.Time {
+		return now
+	}
+	uuid0, err := e.Next()
+	if err != nil {
+		t.Fatalf("unexpected error before expiry: %v", err)
+	}
+	uuid1, err := e.Next()
+	if err != nil {
+		t.Fatalf("unexpected error before expiry: %v", err)
+	}
+	if uuid0 == uuid1 {
+		t.Fatalf("non-unique uuid %x", uuid0)
+	}
+	now = now.Add(time.Minute)
+	uuid, err := e.Next()
+	if err != ErrExpired {
+		t.Fatalf("unexpected error after expiry; got %v want %v", err, ErrExpired)
+	}
+	if uuid != ([24]byte{}) {
+		t.Fatalf("unexpected UUID after expiry: %x", uuid)
+	}
+}
@@ -0,0 +1,34 @@
+package fastuuid
+
+// ExtractHex128 returns all the UUIDs in the dashed hex format
+// accepted by ParseHex128CI that occur in s, in order, decoded
+// as by ParseHex128CI. A UUID is only recognized when it is not
+// immediately preceded or followed by a hex digit or a dash, so
+// that parts of longer hex strings are not mistaken for UUIDs.
+func ExtractHex128(s string) [][24]byte {
+	var uuids [][24]byte
+	for i := 0; i+Hex128Len <= len(s); i++ {
+		if s[i+8] != '-' || s[i+13] != '-' || s[i+18] != '-' || s[i+23] != '-' {
+			continue
+		}
+		if i > 0 && isHexOrDash(s[i-1]) {
+			continue
+		}
+		end := i + Hex128Len
+		if end < len(s) && isHexOrDash(s[end]) {
+			continue
+		}
+		uuid, err := ParseHex128CI(s[i:end])
+		if err != nil {
+			continue
+		}
+		uuids = append(uuids, uuid)
+		i = end - 1
+	}
+	return uuids
+}
+
+func isHexOrDash(c byte) bool {
+	_, ok := fromHexCharCI(c)
+	return ok || c == '-'
+}
@@ -0,0 +1,58 @@
+package fastuuid
+
+import (
+	"reflect"
+	"testing"
+)
+
+var extractHex128Tests = []struct {
+	about string
+	s     string
+	want  []string
+}{{
+	about: "empty",
+	s:     "",
+}, {
+	about: "no uuids",
+	s:     "request failed: connection reset by peer",
+}, {
+	about: "one uuid",
+	s:     "request 01020304-0506-0708-090a-0b0c0d0e0f10 failed",
+	want:  []string{"01020304-0506-0708-090a-0b0c0d0e0f10"},
+}, {
+	about: "whole string",
+	s:     "01020304-0506-0708-090a-0b0c0d0e0f10",
+	want:  []string{"01020304-0506-0708-090a-0b0c0d0e0f10"},
+}, {
+	about: "several uuids",
+	s:     "user=a1a2a3a4-a5a6-a7a8-a9aa-abacadaeafb0 session=(01020304-0506-0708-090A-0B0C0D0E0F10),11111111-2222-3333-4444-555555555555.",
+	want: []string{
+		"a1a2a3a4-a5a6-a7a8-a9aa-abacadaeafb0",
+		"01020304-0506-0708-090a-0b0c0d0e0f10",
+		"11111111-2222-3333-4444-555555555555",
+	},
+}, {
+	about: "part of a longer hex string",
+	s:     "001020304-0506-0708-090a-0b0c0d0e0f10 01020304-0506-0708-090a-0b0c0d0e0f100",
+}, {
+	about: "invalid hex",
+	s:     "0102030x-0506-0708-090a-0b0c0d0e0f10",
+}}
+
+func TestExtractHex128(t *testing.T) {
+	for _, test := range extractHex128Tests {
+		t.Run(test.about, func(t *testing.T) {
+			var want [][24]byte
+			for _, s := range test.want {
+				uuid, err := ParseHex128(s)
+				if err != nil {
+					t.Fatalf("bad test UUID %q: %v", s, err)
+				}
+				want = append(want, uuid)
+			}
+			if got := ExtractHex128(test.s); !reflect.DeepEqual(got, want) {
+				t.Fatalf("unexpected ExtractHex128 result; got %x want %x", got, want)
+			}
+		})
+	}
+}
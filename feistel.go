@@ -0,0 +1,39 @@
+package fastuuid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+)
+
+// feistel applies a balanced Feistel network with the given number
+// of rounds to data in place, or its inverse if inverse is true.
+// Each half of data is len(data)/2 bytes, which must be less than
+// aes.BlockSize so that a half fits in a block after the round
+// number. The round function encrypts the round number followed by
+// one half with block and truncates the result to the width of a
+// half.
+//
+// Rather than swapping the halves after each round, even rounds
+// update the first half and odd rounds the second, which gives the
+// same result as the textbook form when rounds is even.
+func feistel(block cipher.Block, data []byte, rounds int, inverse bool) {
+	w := len(data) / 2
+	l, r := data[:w], data[w:2*w]
+	var buf [aes.BlockSize]byte
+	for i := 0; i < rounds; i++ {
+		round := i
+		if inverse {
+			round = rounds - 1 - i
+		}
+		dst, src := l, r
+		if round%2 != 0 {
+			dst, src = r, l
+		}
+		buf = [aes.BlockSize]byte{byte(round)}
+		copy(buf[1:], src)
+		block.Encrypt(buf[:], buf[:])
+		for j := range dst {
+			dst[j] ^= buf[j]
+		}
+	}
+}
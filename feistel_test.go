@@ -0,0 +1,29 @@
+package fastuuid
+
+import (
+	"bytes"
+	"crypto/aes"
+	"testing"
+)
+
+func TestFeistelRoundTrip(t *testing.T) {
+	block, err := aes.NewCipher(make([]byte, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for w := 1; w < aes.BlockSize; w++ {
+		data := make([]byte, 2*w)
+		for i := range data {
+			data[i] = byte(i + 1)
+		}
+		orig := bytes.Clone(data)
+		feistel(block, data, 4, false)
+		if bytes.Equal(data, orig) {
+			t.Fatalf("data unchanged by permutation for half width %d", w)
+		}
+		feistel(block, data, 4, true)
+		if !bytes.Equal(data, orig) {
+			t.Fatalf("unexpected round trip result for half width %d; got %x want %x", w, data, orig)
+		}
+	}
+}
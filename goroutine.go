@@ -0,0 +1,65 @@
+package fastuuid
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+// GoroutineGenerator is a generator whose UUIDs start with a token
+// that is usually the same for UUIDs generated by the same
+// goroutine, which can help when grouping UUIDs from traces.
+//
+// The UUIDs returned by Next have the following layout:
+//
+//	bytes 0..8   the token, little-endian
+//	bytes 8..16  the counter, little-endian
+//	bytes 16..24 random seed bytes
+//
+// Go does not expose goroutine identity, so tokens are held in a
+// sync.Pool and a goroutine takes one on each call to Next. This is
+// only a heuristic: a goroutine that moves to a different thread or
+// runs after a garbage collection may see a different token, and
+// goroutines that run one after another may see the same one. Two
+// calls to Next that run at the same time always use different
+// tokens. The token does not affect uniqueness, which comes from
+// the counter alone.
+type GoroutineGenerator struct {
+	g      *Generator
+	tokens *Generator
+	pool   sync.Pool
+}
+
+// NewGoroutineGenerator returns a new GoroutineGenerator.
+// It can fail if the crypto/rand read fails.
+func NewGoroutineGenerator() (*GoroutineGenerator, error) {
+	g, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	return &GoroutineGenerator{
+		g:      g,
+		tokens: tokens,
+	}, nil
+}
+
+// Next returns the next UUID from the generator.
+//
+// It is OK to call this method concurrently.
+func (g *GoroutineGenerator) Next() [24]byte {
+	token, _ := g.pool.Get().(*uint64)
+	if token == nil {
+		token = new(uint64)
+		*token = CounterOf(g.tokens.Next())
+	}
+	next := g.g.Next()
+	var uuid [24]byte
+	binary.LittleEndian.PutUint64(uuid[0:8], *token)
+	copy(uuid[8:16], next[0:8])
+	copy(uuid[16:], next[16:])
+	g.pool.Put(token)
+	return uuid
+}
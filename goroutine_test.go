@@ -0,0 +1,73 @@
+package fastuuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestGoroutineGeneratorSameGoroutine(t *testing.T) {
+	if raceEnabled {
+		t.Skip("sync.Pool does not retain tokens reliably under the race detector")
+	}
+	g, err := NewGoroutineGenerator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The token can change if the goroutine migrates or the
+	// pool is cleared, so only require that most calls share it.
+	const n = 100
+	counts := make(map[[8]byte]int)
+	for i := 0; i < n; i++ {
+		uuid := g.Next()
+		counts[[8]byte(uuid[:8])]++
+	}
+	best := 0
+	for _, count := range counts {
+		best = max(best, count)
+	}
+	if best < n/2 {
+		t.Fatalf("UUIDs from one goroutine do not share a token; counts %v", counts)
+	}
+}
+
+func TestGoroutineGeneratorConcurrentTokens(t *testing.T) {
+	g, err := NewGoroutineGenerator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuid0 := g.Next()
+	// Hold the token as if another goroutine's call to
+	// Next were in progress.
+	token := g.pool.Get()
+	uuid1 := g.Next()
+	g.pool.Put(token)
+	if [8]byte(uuid0[:8]) == [8]byte(uuid1[:8]) {
+		t.Fatalf("concurrent calls share a token %x", uuid0[:8])
+	}
+}
+
+func TestGoroutineGeneratorUnique(t *testing.T) {
+	g, err := NewGoroutineGenerator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	seen := make(map[[24]byte]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				uuid := g.Next()
+				mu.Lock()
+				if seen[uuid] {
+					t.Errorf("duplicate UUID %x", uuid)
+				}
+				seen[uuid] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+}
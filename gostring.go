@@ -0,0 +1,36 @@
+package fastuuid
+
+import "strconv"
+
+// GoString implements fmt.GoStringer by returning uuid as a Go
+// composite literal such as
+//
+//	fastuuid.UUID{0x01, 0x02, ..., 0x18}
+//
+// so that printing a UUID with the %#v verb produces code
+// that can be pasted into a Go source file.
+func (uuid UUID) GoString() string {
+	const digits = "0123456789abcdef"
+	b := make([]byte, 0, len("fastuuid.UUID{}")+len(uuid)*len("0x00, "))
+	b = append(b, "fastuuid.UUID{"...)
+	for i, c := range uuid {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = append(b, '0', 'x', digits[c>>4], digits[c&0xf])
+	}
+	b = append(b, '}')
+	return string(b)
+}
+
+// DebugString returns a human-readable description of uuid for
+// debugging, consisting of its Hex128 form followed by the
+// counter value in the layout returned by Generator.Next,
+// for example
+//
+//	e17f4ac5-1f49-4f57-8d1a-e4c7ea72b0a3 (counter=42)
+//
+// The format is intended for people and may change.
+func (uuid UUID) DebugString() string {
+	return Hex128(uuid) + " (counter=" + strconv.FormatUint(CounterOf(uuid), 10) + ")"
+}
@@ -0,0 +1,50 @@
+package fastuuid
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"strconv"
+	"testing"
+)
+
+func TestGoString(t *testing.T) {
+	var uuid UUID
+	for i := range uuid {
+		uuid[i] = byte(i*11 + 1)
+	}
+	s := fmt.Sprintf("%#v", uuid)
+	if got, want := s[:len("fastuuid.UUID{0x01, 0x0c,")], "fastuuid.UUID{0x01, 0x0c,"; got != want {
+		t.Fatalf("unexpected GoString prefix; got %q want %q", got, want)
+	}
+	expr, err := parser.ParseExpr(s)
+	if err != nil {
+		t.Fatalf("GoString result %q does not parse: %v", s, err)
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		t.Fatalf("GoString result %q is not a composite literal", s)
+	}
+	if len(lit.Elts) != len(uuid) {
+		t.Fatalf("unexpected element count; got %d want %d", len(lit.Elts), len(uuid))
+	}
+	var got UUID
+	for i, elt := range lit.Elts {
+		x, err := strconv.ParseUint(elt.(*ast.BasicLit).Value, 0, 8)
+		if err != nil {
+			t.Fatalf("invalid element %d: %v", i, err)
+		}
+		got[i] = byte(x)
+	}
+	if got != uuid {
+		t.Fatalf("GoString does not reproduce the UUID; got %x want %x", got, uuid)
+	}
+}
+
+func TestDebugString(t *testing.T) {
+	uuid := UUID(ComposeDefault(42, [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	got := uuid.DebugString()
+	if want := Hex128(uuid) + " (counter=42)"; got != want {
+		t.Fatalf("unexpected DebugString result; got %q want %q", got, want)
+	}
+}
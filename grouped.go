@@ -0,0 +1,44 @@
+package fastuuid
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+)
+
+// HexGrouped returns the bytes of uuid as lower case hex with
+// dashes separating groups of digits. Each element of groups holds
+// the number of hex digits in the corresponding group. The groups
+// must add up to an even number of digits no greater than 48, and
+// only that many digits from the start of uuid are encoded. No
+// version or variant bits are changed.
+//
+// For example, groups of []int{8, 4, 4, 4, 12} give the
+// layout used by Hex128, and []int{8, 8, 8} encodes the
+// first 12 bytes in three groups of 8 digits.
+func HexGrouped(uuid [24]byte, groups []int) (string, error) {
+	if len(groups) == 0 {
+		return "", errors.New("no hex groups specified")
+	}
+	total := 0
+	for _, n := range groups {
+		if n <= 0 {
+			return "", errors.New("invalid hex group size " + strconv.Itoa(n))
+		}
+		total += n
+	}
+	if total%2 != 0 || total > len(uuid)*2 {
+		return "", errors.New("hex groups add up to " + strconv.Itoa(total) + " digits, which is not an even number of at most 48")
+	}
+	digits := make([]byte, total)
+	hex.Encode(digits, uuid[:total/2])
+	b := make([]byte, 0, total+len(groups)-1)
+	for i, n := range groups {
+		if i > 0 {
+			b = append(b, '-')
+		}
+		b = append(b, digits[:n]...)
+		digits = digits[n:]
+	}
+	return string(b), nil
+}
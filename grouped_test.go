@@ -0,0 +1,65 @@
+package fastuuid
+
+import "testing"
+
+var hexGroupedTests = []struct {
+	about       string
+	groups      []int
+	want        string
+	expectError string
+}{{
+	about:  "standard",
+	groups: []int{8, 4, 4, 4, 12},
+	want:   "01020304-0506-0708-090a-0b0c0d0e0f10",
+}, {
+	about:  "eight-eight-eight",
+	groups: []int{8, 8, 8},
+	want:   "01020304-05060708-090a0b0c",
+}, {
+	about:  "single group of everything",
+	groups: []int{48},
+	want:   "0102030405060708090a0b0c0d0e0f101112131415161718",
+}, {
+	about:  "odd group sizes",
+	groups: []int{3, 5},
+	want:   "010-20304",
+}, {
+	about:       "no groups",
+	expectError: "no hex groups specified",
+}, {
+	about:       "odd total",
+	groups:      []int{8, 3},
+	expectError: "hex groups add up to 11 digits, which is not an even number of at most 48",
+}, {
+	about:       "too many digits",
+	groups:      []int{32, 18},
+	expectError: "hex groups add up to 50 digits, which is not an even number of at most 48",
+}, {
+	about:       "empty group",
+	groups:      []int{8, 0, 8},
+	expectError: "invalid hex group size 0",
+}}
+
+func TestHexGrouped(t *testing.T) {
+	var uuid [24]byte
+	for i := range uuid {
+		uuid[i] = byte(i + 1)
+	}
+	for _, test := range hexGroupedTests {
+		t.Run(test.about, func(t *testing.T) {
+			got, err := HexGrouped(uuid, test.groups)
+			if test.expectError != "" {
+				if err == nil || err.Error() != test.expectError {
+					t.Fatalf("unexpected error; got %v want %q", err, test.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("unexpected HexGrouped result; got %q want %q", got, test.want)
+			}
+		})
+	}
+}
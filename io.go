@@ -0,0 +1,22 @@
+package fastuuid
+
+import "io"
+
+// WriteTo implements io.WriterTo by writing the RawLen
+// raw bytes of uuid to w.
+func (uuid UUID) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(uuid[:])
+	return int64(n), err
+}
+
+// ReadUUID reads a UUID in the form written by UUID.WriteTo,
+// consuming exactly RawLen bytes from r. If fewer bytes are
+// available, it returns io.ErrUnexpectedEOF, or io.EOF if no
+// bytes could be read at all.
+func ReadUUID(r io.Reader) ([24]byte, error) {
+	var uuid [24]byte
+	if _, err := io.ReadFull(r, uuid[:]); err != nil {
+		return [24]byte{}, err
+	}
+	return uuid, nil
+}
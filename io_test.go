@@ -0,0 +1,50 @@
+package fastuuid
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReadUUID(t *testing.T) {
+	uuid := UUID(MustNewGenerator().Next())
+	var buf bytes.Buffer
+	n, err := uuid.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != RawLen {
+		t.Fatalf("unexpected byte count; got %d want %d", n, RawLen)
+	}
+	buf.WriteString("extra")
+	got, err := ReadUUID(iotest.OneByteReader(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != uuid {
+		t.Fatalf("unexpected UUID; got %x want %x", got, uuid)
+	}
+	if rest := buf.String(); rest != "extra" {
+		t.Fatalf("unexpected remaining data; got %q want %q", rest, "extra")
+	}
+}
+
+func TestReadUUIDShort(t *testing.T) {
+	for _, test := range []struct {
+		data    []byte
+		wantErr error
+	}{
+		{nil, io.EOF},
+		{make([]byte, 1), io.ErrUnexpectedEOF},
+		{make([]byte, RawLen-1), io.ErrUnexpectedEOF},
+	} {
+		uuid, err := ReadUUID(bytes.NewReader(test.data))
+		if err != test.wantErr {
+			t.Errorf("unexpected error for %d bytes; got %v want %v", len(test.data), err, test.wantErr)
+		}
+		if uuid != [24]byte{} {
+			t.Errorf("non-zero UUID returned on error")
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package fastuuid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+)
+
+// generatorState holds the JSON form of a Generator
+// or an EscapedGenerator.
+type generatorState struct {
+	Seed    string `json:"seed"`
+	Counter uint64 `json:"counter"`
+	// Delta is omitted for generators that count
+	// upwards by one.
+	Delta *uint64 `json:"delta,omitempty"`
+	// Forbidden is present only for the state
+	// of an EscapedGenerator.
+	Forbidden *byte `json:"forbidden,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler by encoding the full state
+// of the generator, including its seed, as a JSON object such as
+//
+//	{"seed":"0102...18","counter":1234}
+//
+// The counter is read atomically, so it is OK to call this
+// concurrently with Next.
+//
+// Note that the seed makes all UUIDs from the generator
+// predictable, so the result should be kept private.
+func (g *Generator) MarshalJSON() ([]byte, error) {
+	seed := g.loadSeed()
+	state := generatorState{
+		Seed:    hex.EncodeToString(seed[:]),
+		Counter: atomic.LoadUint64(&g.counter),
+	}
+	if delta := g.skip + 1; delta != 1 {
+		state.Delta = &delta
+	}
+	return json.Marshal(state)
+}
+
+// UnmarshalJSON implements json.Unmarshaler by restoring
+// the state encoded by MarshalJSON. It must not be called
+// concurrently with any other method on g.
+func (g *Generator) UnmarshalJSON(data []byte) error {
+	var state generatorState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	if state.Forbidden != nil {
+		return errors.New("cannot restore escaped generator state into Generator")
+	}
+	seed, err := state.seed()
+	if err != nil {
+		return err
+	}
+	delta := uint64(1)
+	if state.Delta != nil {
+		delta = *state.Delta
+	}
+	if delta == 0 {
+		return errors.New("invalid generator delta 0")
+	}
+	g.seed = seed
+	atomic.StorePointer(&g.tail, nil)
+	atomic.StoreUint64(&g.counter, state.Counter)
+	g.skip = delta - 1
+	atomic.StorePointer(&g.opaque, nil)
+	return nil
+}
+
+// seed returns the seed held in the state.
+func (state *generatorState) seed() ([24]byte, error) {
+	var seed [24]byte
+	if len(state.Seed) != len(seed)*2 {
+		return seed, errors.New("invalid generator seed length")
+	}
+	if _, err := hex.Decode(seed[:], []byte(state.Seed)); err != nil {
+		return [24]byte{}, errors.New("invalid generator seed: " + err.Error())
+	}
+	return seed, nil
+}
+
+// MarshalJSON implements json.Marshaler in the same way as
+// Generator.MarshalJSON, also recording the forbidden byte.
+func (g *EscapedGenerator) MarshalJSON() ([]byte, error) {
+	forbidden := g.forbidden
+	return json.Marshal(generatorState{
+		Seed:      hex.EncodeToString(g.seed[:]),
+		Counter:   atomic.LoadUint64(&g.counter),
+		Forbidden: &forbidden,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler by restoring the
+// state encoded by MarshalJSON. It returns an error if the state
+// is not that of an EscapedGenerator or its seed tail contains
+// the forbidden byte. It must not be called concurrently with
+// any other method on g.
+func (g *EscapedGenerator) UnmarshalJSON(data []byte) error {
+	var state generatorState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	if state.Forbidden == nil {
+		return errors.New("missing forbidden byte in escaped generator state")
+	}
+	if state.Delta != nil {
+		return errors.New("unexpected delta in escaped generator state")
+	}
+	seed, err := state.seed()
+	if err != nil {
+		return err
+	}
+	if bytes.IndexByte(seed[8:], *state.Forbidden) >= 0 {
+		return errors.New("escaped generator seed contains forbidden byte")
+	}
+	g.seed = seed
+	atomic.StoreUint64(&g.counter, state.Counter)
+	g.forbidden = *state.Forbidden
+	return nil
+}
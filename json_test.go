@@ -0,0 +1,111 @@
+package fastuuid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGeneratorJSON(t *testing.T) {
+	for _, newGenerator := range []func() (*Generator, error){
+		NewGenerator,
+		NewDescendingGenerator,
+	} {
+		g, err := newGenerator()
+		if err != nil {
+			t.Fatalf("cannot make generator: %v", err)
+		}
+		g.Next()
+		data, err := json.Marshal(g)
+		if err != nil {
+			t.Fatalf("cannot marshal: %v", err)
+		}
+		var g1 Generator
+		if err := json.Unmarshal(data, &g1); err != nil {
+			t.Fatalf("cannot unmarshal %s: %v", data, err)
+		}
+		for i := 0; i < 3; i++ {
+			if got, want := g1.Next(), g.Next(); got != want {
+				t.Fatalf("unexpected next UUID after round trip; got %x want %x", got, want)
+			}
+		}
+	}
+}
+
+func TestEscapedGeneratorJSON(t *testing.T) {
+	g, err := NewEscapedGenerator(0xff)
+	if err != nil {
+		t.Fatalf("cannot make generator: %v", err)
+	}
+	g.Next()
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("cannot marshal: %v", err)
+	}
+	var g1 EscapedGenerator
+	if err := json.Unmarshal(data, &g1); err != nil {
+		t.Fatalf("cannot unmarshal %s: %v", data, err)
+	}
+	for i := 0; i < 3; i++ {
+		if got, want := g1.Next(), g.Next(); got != want {
+			t.Fatalf("unexpected next UUID after round trip; got %x want %x", got, want)
+		}
+	}
+	// The state of an escaped generator cannot be
+	// restored into a plain generator or vice versa.
+	var g2 Generator
+	if err := json.Unmarshal(data, &g2); err == nil {
+		t.Fatalf("expected error unmarshaling escaped state into Generator")
+	}
+	data, err = json.Marshal(MustNewGenerator())
+	if err != nil {
+		t.Fatalf("cannot marshal: %v", err)
+	}
+	if err := json.Unmarshal(data, &g1); err == nil {
+		t.Fatalf("expected error unmarshaling Generator state into EscapedGenerator")
+	}
+}
+
+func TestEscapedGeneratorUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{
+		`{"seed":"0102030405060708090a0b0c0d0e0f101112131415161718","counter":1}`,
+		`{"seed":"0102030405060708090a0b0c0d0e0f101112131415161718","counter":1,"forbidden":16}`,
+		`{"seed":"0102030405060708090a0b0c0d0e0f101112131415161718","counter":1,"forbidden":255,"delta":2}`,
+		`{"seed":"0102","counter":1,"forbidden":255}`,
+	} {
+		var g EscapedGenerator
+		if err := json.Unmarshal([]byte(data), &g); err == nil {
+			t.Errorf("expected error unmarshaling %s", data)
+		}
+	}
+}
+
+func TestGeneratorMarshalJSON(t *testing.T) {
+	var g Generator
+	if err := json.Unmarshal([]byte(`{"seed":"0102030405060708090a0b0c0d0e0f101112131415161718","counter":42}`), &g); err != nil {
+		t.Fatalf("cannot unmarshal: %v", err)
+	}
+	if got, want := g.Next(), [24]byte{43, 0, 0, 0, 0, 0, 0, 0, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}; got != want {
+		t.Fatalf("unexpected UUID; got %x want %x", got, want)
+	}
+	data, err := json.Marshal(&g)
+	if err != nil {
+		t.Fatalf("cannot marshal: %v", err)
+	}
+	if got, want := string(data), `{"seed":"0102030405060708090a0b0c0d0e0f101112131415161718","counter":43}`; got != want {
+		t.Fatalf("unexpected JSON; got %s want %s", got, want)
+	}
+}
+
+func TestGeneratorUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{
+		`{"seed":"0102","counter":1}`,
+		`{"seed":"zz02030405060708090a0b0c0d0e0f101112131415161718","counter":1}`,
+		`{"seed":1}`,
+		`{"seed":"0102030405060708090a0b0c0d0e0f101112131415161718","counter":1,"delta":0}`,
+	} {
+		var g Generator
+		if err := json.Unmarshal([]byte(data), &g); err == nil {
+			t.Errorf("expected error unmarshaling %s", data)
+		}
+	}
+}
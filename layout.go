@@ -0,0 +1,59 @@
+package fastuuid
+
+// ByteRange describes the bytes from Start up to
+// but not including End.
+type ByteRange struct {
+	Start, End int
+}
+
+// ByteLayout describes the byte layout of the UUIDs returned by
+// Generator.Next and of the 128 bit form produced from them by
+// Hex128. See Layout.
+type ByteLayout struct {
+	// Counter holds the bytes of the counter,
+	// which is stored in little-endian order.
+	Counter ByteRange
+
+	// SeedTail holds the bytes of the random seed tail,
+	// which are the same in every UUID from a generator
+	// unless Generator.SetSeedTail is called.
+	SeedTail ByteRange
+
+	// Hex128 holds the bytes that are included
+	// in the 128 bit form returned by Hex128.
+	Hex128 ByteRange
+
+	// Hex128Swap holds the indexes of two bytes that Hex128
+	// exchanges before setting the version and variant bits,
+	// so that no counter bits are overwritten.
+	Hex128Swap [2]int
+
+	// VersionByte holds the index of the byte whose
+	// VersionMask bits Hex128 replaces with Version.
+	VersionByte int
+	VersionMask byte
+	Version     byte
+
+	// VariantByte holds the index of the byte whose
+	// VariantMask bits Hex128 replaces with Variant.
+	VariantByte int
+	VariantMask byte
+	Variant     byte
+}
+
+// Layout describes the layout used by Generator.Next and Hex128,
+// so that code can find the counter, the random bytes and the
+// bits rewritten by Hex128 without hard-coding their offsets.
+// It must not be modified.
+var Layout = ByteLayout{
+	Counter:     ByteRange{0, 8},
+	SeedTail:    ByteRange{8, 24},
+	Hex128:      ByteRange{0, 16},
+	Hex128Swap:  [2]int{6, 9},
+	VersionByte: 6,
+	VersionMask: 0xf0,
+	Version:     0x40,
+	VariantByte: 8,
+	VariantMask: 0xc0,
+	Variant:     0x80,
+}
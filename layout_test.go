@@ -0,0 +1,48 @@
+package fastuuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestLayoutNext(t *testing.T) {
+	g := MustNewGenerator()
+	first := g.Next()
+	counter := Layout.Counter
+	tail := Layout.SeedTail
+	if counter.End-counter.Start != 8 || tail.Start != counter.End || tail.End != RawLen {
+		t.Fatalf("layout does not cover all bytes: %+v", Layout)
+	}
+	for i := uint64(1); i <= 100; i++ {
+		uuid := g.Next()
+		if got, want := binary.LittleEndian.Uint64(uuid[counter.Start:counter.End]), binary.LittleEndian.Uint64(first[counter.Start:counter.End])+i; got != want {
+			t.Fatalf("unexpected counter; got %d want %d", got, want)
+		}
+		if !bytes.Equal(uuid[tail.Start:tail.End], first[tail.Start:tail.End]) {
+			t.Fatalf("seed tail changed; got %x want %x", uuid[tail.Start:tail.End], first[tail.Start:tail.End])
+		}
+	}
+}
+
+func TestLayoutHex128(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 1000; i++ {
+		uuid := g.Next()
+		// Apply the layout's description of Hex128
+		// by hand and check that it matches.
+		want := uuid
+		a, b := Layout.Hex128Swap[0], Layout.Hex128Swap[1]
+		want[a], want[b] = want[b], want[a]
+		want[Layout.VersionByte] = want[Layout.VersionByte]&^Layout.VersionMask | Layout.Version
+		want[Layout.VariantByte] = want[Layout.VariantByte]&^Layout.VariantMask | Layout.Variant
+		got, err := ParseHex128(Hex128(uuid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := Layout.Hex128
+		if !bytes.Equal(got[r.Start:r.End], want[r.Start:r.End]) {
+			t.Fatalf("unexpected Hex128 bytes; got %x want %x", got[r.Start:r.End], want[r.Start:r.End])
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package fastuuid
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Mnemonic returns uuid as a sequence of 24 words, one for each
+// byte, chosen from a fixed list of 256 distinct English words.
+// This can make a UUID easier to read aloud or transcribe.
+// ParseMnemonic reverses the encoding.
+func (uuid UUID) Mnemonic() []string {
+	words := make([]string, len(uuid))
+	for i, b := range uuid {
+		words[i] = mnemonicWords[b]
+	}
+	return words
+}
+
+// ParseMnemonic parses a sequence of words as returned
+// by UUID.Mnemonic. The words must be in lower case.
+func ParseMnemonic(words []string) ([24]byte, error) {
+	var uuid [24]byte
+	if len(words) != len(uuid) {
+		return [24]byte{}, errors.New("mnemonic has " + strconv.Itoa(len(words)) + " words, want 24")
+	}
+	for i, w := range words {
+		b, ok := mnemonicIndex[w]
+		if !ok {
+			return [24]byte{}, errors.New("unknown mnemonic word " + strconv.Quote(w))
+		}
+		uuid[i] = b
+	}
+	return uuid, nil
+}
+
+// mnemonicIndex maps each word in mnemonicWords to its index.
+var mnemonicIndex = func() map[string]byte {
+	m := make(map[string]byte, len(mnemonicWords))
+	for i, w := range mnemonicWords {
+		m[w] = byte(i)
+	}
+	return m
+}()
+
+// mnemonicWords holds the words used by UUID.Mnemonic,
+// indexed by byte value.
+var mnemonicWords = [256]string{
+	"acid", "acorn", "actor", "adult", "agent", "alarm", "album", "alien",
+	"alley", "amber", "angle", "ankle", "apple", "apron", "arena",
+	"arrow", "atlas", "attic", "audio", "award", "axis", "bacon", "badge",
+	"bagel", "baker", "bamboo", "banjo", "barn", "basil", "basin",
+	"beach", "beard", "beetle", "bell", "bench", "berry", "bike", "bingo",
+	"birch", "bison", "blade", "blank", "blaze", "blimp", "bloom",
+	"board", "boat", "bonus", "boot", "bottle", "brain", "brick", "bride",
+	"broom", "brush", "bucket", "bugle", "bunny", "cabin", "cactus",
+	"camel", "candle", "canoe", "canyon", "carpet", "carrot", "castle",
+	"cedar", "cello", "chalk", "cherry", "chess", "cider", "cigar",
+	"circus", "clam", "cliff", "clock", "cloud", "clover", "cobra",
+	"cocoa", "comet", "coral", "cotton", "cowboy", "crab", "crane",
+	"crayon", "crown", "cup", "daisy", "dancer", "delta", "denim",
+	"desert", "dingo", "dolphin", "donkey", "dragon", "drum", "duck",
+	"eagle", "easel", "echo", "eel", "elbow", "elm", "ember", "engine",
+	"falcon", "fern", "ferry", "fiddle", "film", "finch", "flag", "flute",
+	"fossil", "fox", "frog", "garden", "garlic", "gecko", "ghost",
+	"giant", "ginger", "globe", "goat", "gold", "grape", "gravel",
+	"guitar", "hammer", "harbor", "harp", "hawk", "hazel", "helmet",
+	"heron", "honey", "hornet", "hotel", "igloo", "iris", "island",
+	"ivory", "jacket", "jaguar", "jelly", "jewel", "jigsaw", "jungle",
+	"kayak", "kettle", "kiwi", "koala", "ladder", "lagoon", "lamp",
+	"lemon", "lens", "lily", "lion", "lizard", "llama", "locket", "lotus",
+	"magnet", "mango", "maple", "marble", "meadow", "melon", "mirror",
+	"mitten", "monkey", "moose", "moth", "muffin", "needle", "nickel",
+	"noodle", "nutmeg", "oasis", "ocean", "olive", "onion", "orbit",
+	"orchid", "otter", "owl", "oyster", "paddle", "panda", "parrot",
+	"peach", "pebble", "pencil", "pepper", "piano", "pickle", "pigeon",
+	"pillow", "pilot", "pirate", "planet", "plum", "pony", "poppy",
+	"puzzle", "quail", "quartz", "quilt", "rabbit", "radar", "radish",
+	"raven", "ribbon", "river", "robot", "rocket", "ruby", "saddle",
+	"salmon", "sandal", "satin", "scarf", "shovel", "silver", "skate",
+	"sloth", "snail", "socket", "spider", "spoon", "squid", "statue",
+	"sugar", "summit", "swan", "taco", "tiger", "tomato", "tulip",
+	"tunnel", "turtle", "velvet", "violin", "walnut", "walrus", "wizard",
+	"yacht", "yogurt", "zebra", "zipper",
+}
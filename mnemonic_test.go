@@ -0,0 +1,41 @@
+package fastuuid
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+func TestMnemonicRoundTrip(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		var uuid UUID
+		rand.Read(uuid[:])
+		words := uuid.Mnemonic()
+		if len(words) != 24 {
+			t.Fatalf("unexpected word count; got %d want 24", len(words))
+		}
+		got, err := ParseMnemonic(words)
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", words, err)
+		}
+		if got != uuid {
+			t.Fatalf("round trip mismatch; got %x want %x", got, uuid)
+		}
+	}
+}
+
+func TestMnemonicWordsDistinct(t *testing.T) {
+	if len(mnemonicIndex) != len(mnemonicWords) {
+		t.Fatalf("mnemonic words are not distinct")
+	}
+}
+
+func TestParseMnemonicError(t *testing.T) {
+	words := UUID{}.Mnemonic()
+	if _, err := ParseMnemonic(words[1:]); err == nil {
+		t.Errorf("expected error for too few words")
+	}
+	words[5] = "xyzzy"
+	if _, err := ParseMnemonic(words); err == nil {
+		t.Errorf("expected error for unknown word")
+	}
+}
@@ -0,0 +1,54 @@
+package fastuuid
+
+// HexMSSQL returns the string form of the first 16 bytes of uuid
+// interpreted in the byte order used by SQL Server to store
+// uniqueidentifier values. In that order, the first three
+// groups of the string (4, 2 and 2 bytes) are stored
+// little-endian and the last two groups (2 and 6 bytes) are
+// stored as written. For example, the bytes
+//
+//	ff 19 96 6f 86 8b 11 d0 b4 2d 00 c0 4f c9 64 ff
+//
+// are formatted as
+//
+//	6F9619FF-8B86-D011-B42D-00C04FC964FF
+//
+// Upper case hex is used, as SQL Server does. No version or
+// variant bits are changed.
+func HexMSSQL(uuid [24]byte) string {
+	uuid = swapMSSQL(uuid)
+	digits := hexDigits[1]
+	var b [Hex128Len]byte
+	encodeHex(b[0:8], uuid[0:4], digits)
+	b[8] = '-'
+	encodeHex(b[9:13], uuid[4:6], digits)
+	b[13] = '-'
+	encodeHex(b[14:18], uuid[6:8], digits)
+	b[18] = '-'
+	encodeHex(b[19:23], uuid[8:10], digits)
+	b[23] = '-'
+	encodeHex(b[24:], uuid[10:16], digits)
+	return string(b[:])
+}
+
+// ParseHexMSSQL parses a string in the format returned by
+// HexMSSQL into the first 16 bytes of a UUID, in SQL Server
+// byte order. Either case of hex is accepted. The remaining
+// bytes are zero.
+func ParseHexMSSQL(s string) ([24]byte, error) {
+	uuid, err := ParseHex128CI(s)
+	if err != nil {
+		return [24]byte{}, err
+	}
+	return swapMSSQL(uuid), nil
+}
+
+// swapMSSQL converts between SQL Server byte order
+// and the order of the bytes in the string form.
+// It is its own inverse.
+func swapMSSQL(uuid [24]byte) [24]byte {
+	uuid[0], uuid[1], uuid[2], uuid[3] = uuid[3], uuid[2], uuid[1], uuid[0]
+	uuid[4], uuid[5] = uuid[5], uuid[4]
+	uuid[6], uuid[7] = uuid[7], uuid[6]
+	return uuid
+}
@@ -0,0 +1,28 @@
+package fastuuid
+
+import "testing"
+
+func TestHexMSSQL(t *testing.T) {
+	uuid := [24]byte{0xff, 0x19, 0x96, 0x6f, 0x86, 0x8b, 0x11, 0xd0, 0xb4, 0x2d, 0x00, 0xc0, 0x4f, 0xc9, 0x64, 0xff}
+	s := "6F9619FF-8B86-D011-B42D-00C04FC964FF"
+	if got := HexMSSQL(uuid); got != s {
+		t.Fatalf("unexpected HexMSSQL result; got %q want %q", got, s)
+	}
+	for _, s := range []string{s, "6f9619ff-8b86-d011-b42d-00c04fc964ff"} {
+		got, err := ParseHexMSSQL(s)
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", s, err)
+		}
+		if got != uuid {
+			t.Fatalf("unexpected ParseHexMSSQL result for %q; got %x want %x", s, got, uuid)
+		}
+	}
+}
+
+func TestParseHexMSSQLError(t *testing.T) {
+	for _, s := range []string{"", "6F9619FF8B86D011B42D00C04FC964FF", "6F9619FF-8B86-D011-B42D-00C04FC964FG"} {
+		if uuid, err := ParseHexMSSQL(s); err == nil {
+			t.Errorf("expected error for %q, got %x", s, uuid)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package fastuuid
+
+import "encoding/binary"
+
+// NetworkBytes returns uuid with its first 8 bytes, which
+// hold the little-endian counter written by Generator.Next,
+// reversed to big-endian order. Successive UUIDs from a
+// generator then compare in counter order when treated as
+// big-endian byte strings. The remaining 16 bytes are unchanged.
+func (uuid UUID) NetworkBytes() [24]byte {
+	binary.BigEndian.PutUint64(uuid[:8], binary.LittleEndian.Uint64(uuid[:8]))
+	return uuid
+}
+
+// FromNetworkBytes is the inverse of UUID.NetworkBytes.
+func FromNetworkBytes(b [24]byte) UUID {
+	binary.LittleEndian.PutUint64(b[:8], binary.BigEndian.Uint64(b[:8]))
+	return b
+}
@@ -0,0 +1,39 @@
+package fastuuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNetworkBytes(t *testing.T) {
+	var uuid UUID
+	for i := range uuid {
+		uuid[i] = byte(i + 1)
+	}
+	got := uuid.NetworkBytes()
+	want := [24]byte{8, 7, 6, 5, 4, 3, 2, 1, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}
+	if got != want {
+		t.Fatalf("unexpected NetworkBytes result; got %x want %x", got, want)
+	}
+	if back := FromNetworkBytes(got); back != uuid {
+		t.Fatalf("unexpected FromNetworkBytes result; got %x want %x", back, uuid)
+	}
+	if twice := UUID(got).NetworkBytes(); twice != uuid {
+		t.Fatalf("NetworkBytes is not its own inverse; got %x want %x", twice, uuid)
+	}
+}
+
+func TestNetworkBytesOrder(t *testing.T) {
+	g := MustNewGenerator()
+	// Start the counter just below a byte boundary
+	// so that the little-endian form does not sort.
+	g.counter = 0xfe
+	prev := UUID(g.Next()).NetworkBytes()
+	for i := 0; i < 10; i++ {
+		cur := UUID(g.Next()).NetworkBytes()
+		if bytes.Compare(prev[:], cur[:]) >= 0 {
+			t.Fatalf("network bytes out of order; %x >= %x", prev, cur)
+		}
+		prev = cur
+	}
+}
@@ -0,0 +1,5 @@
+//go:build !race
+
+package fastuuid
+
+const raceEnabled = false
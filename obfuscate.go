@@ -0,0 +1,77 @@
+package fastuuid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+)
+
+// obfuscatorRounds holds the number of Feistel rounds
+// used by Obfuscator.
+const obfuscatorRounds = 4
+
+// Obfuscator converts UUIDs to and from display IDs that look
+// random, so that publicly visible IDs do not reveal the counters
+// of UUIDs returned by Generator.Next and hence the order or
+// number of UUIDs issued.
+//
+// Encode applies a keyed permutation to all 24 bytes of a UUID
+// and formats the result like Base64. Unlike a hash, the
+// permutation is reversible: any holder of the key can recover
+// the original UUID with Decode, and distinct UUIDs always have
+// distinct display IDs. Without the key, display IDs are
+// indistinguishable from random strings, but the key must be
+// kept secret as anyone who has it can decode them. Encoding
+// the same UUID twice produces the same display ID, so display
+// IDs can be compared for equality.
+//
+// The permutation is a 4-round Feistel network over two 12 byte
+// halves whose round function is AES keyed by a SHA-256 hash
+// of the key.
+//
+// It is OK to use an Obfuscator concurrently.
+type Obfuscator struct {
+	block cipher.Block
+}
+
+// NewObfuscator returns an Obfuscator that uses the
+// given secret key, which must not be empty.
+func NewObfuscator(key []byte) (*Obfuscator, error) {
+	if len(key) == 0 {
+		return nil, errors.New("empty key passed to NewObfuscator")
+	}
+	sum := sha256.Sum256(key)
+	block, err := aes.NewCipher(sum[:16])
+	if err != nil {
+		return nil, err
+	}
+	return &Obfuscator{block: block}, nil
+}
+
+// Encode returns the display ID for uuid. The result is
+// always Base64Len characters long.
+func (o *Obfuscator) Encode(uuid [24]byte) string {
+	return Base64(o.permute(uuid, false))
+}
+
+// Decode returns the UUID for a display ID returned by Encode.
+func (o *Obfuscator) Decode(s string) ([24]byte, error) {
+	if len(s) != Base64Len {
+		return [24]byte{}, errors.New("invalid obfuscated UUID " + strconv.Quote(s))
+	}
+	var uuid [24]byte
+	if _, err := base64.RawURLEncoding.Decode(uuid[:], []byte(s)); err != nil {
+		return [24]byte{}, errors.New("invalid obfuscated UUID " + strconv.Quote(s))
+	}
+	return o.permute(uuid, true), nil
+}
+
+// permute applies the Encode permutation to uuid,
+// or its inverse if inverse is true.
+func (o *Obfuscator) permute(uuid [24]byte, inverse bool) [24]byte {
+	feistel(o.block, uuid[:], obfuscatorRounds, inverse)
+	return uuid
+}
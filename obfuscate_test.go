@@ -0,0 +1,72 @@
+package fastuuid
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestObfuscator(t *testing.T) {
+	o, err := NewObfuscator([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := MustNewGenerator()
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		uuid := g.Next()
+		s := o.Encode(uuid)
+		if len(s) != Base64Len {
+			t.Fatalf("unexpected length of %q; got %d want %d", s, len(s), Base64Len)
+		}
+		if s == Base64(uuid) {
+			t.Fatalf("display ID %q is not obfuscated", s)
+		}
+		if o.Encode(uuid) != s {
+			t.Fatalf("Encode is not deterministic")
+		}
+		if seen[s] {
+			t.Fatalf("duplicate display ID %q", s)
+		}
+		seen[s] = true
+		got, err := o.Decode(s)
+		if err != nil {
+			t.Fatalf("cannot decode %q: %v", s, err)
+		}
+		if got != uuid {
+			t.Fatalf("round trip mismatch; got %x want %x", got, uuid)
+		}
+	}
+}
+
+func TestObfuscatorKey(t *testing.T) {
+	o1, err := NewObfuscator([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	o2, err := NewObfuscator([]byte("secreT"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuid := MustNewGenerator().Next()
+	if o1.Encode(uuid) == o2.Encode(uuid) {
+		t.Fatalf("different keys produce the same display ID")
+	}
+	if got, _ := o2.Decode(o1.Encode(uuid)); got == uuid {
+		t.Fatalf("display ID decoded with the wrong key")
+	}
+}
+
+func TestObfuscatorErrors(t *testing.T) {
+	if _, err := NewObfuscator(nil); err == nil {
+		t.Fatalf("expected error for empty key")
+	}
+	o, err := NewObfuscator([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"", "AQIDBAUGBwgJCgsMDQ4PEBESExQVFhc", "AQIDBAUGBwgJCgsMDQ4PEBESExQVFhc!"} {
+		if _, err := o.Decode(s); err == nil || err.Error() != "invalid obfuscated UUID "+strconv.Quote(s) {
+			t.Errorf("unexpected error for %q: %v", s, err)
+		}
+	}
+}
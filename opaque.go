@@ -0,0 +1,96 @@
+package fastuuid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync/atomic"
+	"unsafe"
+)
+
+// opaqueRounds holds the number of Feistel rounds
+// used by NextOpaque. Four rounds would only be secure
+// up to about 2^16 outputs for 32 bit halves; more rounds
+// push the bound towards the 2^32 limit of a 64 bit block.
+const opaqueRounds = 8
+
+// NextOpaque is like Next except that the counter is passed
+// through a keyed permutation before being stored in the
+// first 8 bytes of the UUID, so successive UUIDs are not
+// adjacent and do not reveal the order or number of UUIDs
+// issued. RevealOpaque can be used to undo the permutation.
+//
+// The permutation is an 8-round Feistel network over the two
+// 32 bit halves of the counter whose round function is AES keyed
+// by a SHA-256 hash of the generator's seed. As the permuted value
+// is only 64 bits wide, the outputs are indistinguishable from
+// random only up to about 2^32 UUIDs. Random 64 bit values would
+// be expected to start colliding around then (the birthday bound),
+// so the fact that these never repeat gives them away. Use an
+// Obfuscator, which permutes all 24 bytes, if more UUIDs than
+// that must be hidden.
+//
+// Because the last 16 bytes of the seed are present in
+// every UUID, the secrecy of the permutation rests on the
+// first 8 bytes of the seed, which are only revealed by the
+// counter values returned from Next. Mixing calls to Next and
+// NextOpaque on the same generator therefore makes it
+// possible to recover the permutation. The key is taken
+// from the seed when the permutation is first used and
+// is not changed by SetSeedTail.
+//
+// NextOpaque is considerably slower than Next.
+//
+// It is OK to call this method concurrently.
+func (g *Generator) NextOpaque() [24]byte {
+	x := atomic.AddUint64(&g.counter, g.skip+1)
+	uuid := g.loadSeed()
+	binary.LittleEndian.PutUint64(uuid[:8], g.permuteCounter(x, false))
+	return uuid
+}
+
+// RevealOpaque returns the UUID that Next would have
+// returned in place of the given UUID returned by
+// NextOpaque on the same generator.
+func (g *Generator) RevealOpaque(uuid [24]byte) [24]byte {
+	x := binary.LittleEndian.Uint64(uuid[:8])
+	binary.LittleEndian.PutUint64(uuid[:8], g.permuteCounter(x, true))
+	return uuid
+}
+
+// permuteCounter applies the NextOpaque permutation to x,
+// or its inverse if inverse is true.
+func (g *Generator) permuteCounter(x uint64, inverse bool) uint64 {
+	key := (*opaqueKey)(atomic.LoadPointer(&g.opaque))
+	if key == nil {
+		key = g.newOpaqueKey()
+	}
+	var buf [8]byte
+	binary.LittleEndian.PutUint32(buf[:4], uint32(x>>32))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(x))
+	feistel(key.block, buf[:], opaqueRounds, inverse)
+	return uint64(binary.LittleEndian.Uint32(buf[:4]))<<32 | uint64(binary.LittleEndian.Uint32(buf[4:]))
+}
+
+// opaqueKey holds the cipher used by NextOpaque.
+type opaqueKey struct {
+	block cipher.Block
+}
+
+// newOpaqueKey creates the key for NextOpaque from the seed and
+// stores it in g.opaque. If two goroutines race to do so, the
+// first key to be stored is used by both.
+func (g *Generator) newOpaqueKey() *opaqueKey {
+	seed := g.loadSeed()
+	sum := sha256.Sum256(seed[:])
+	block, err := aes.NewCipher(sum[:16])
+	if err != nil {
+		panic(err)
+	}
+	key := &opaqueKey{block: block}
+	if !atomic.CompareAndSwapPointer(&g.opaque, nil, unsafe.Pointer(key)) {
+		key = (*opaqueKey)(atomic.LoadPointer(&g.opaque))
+	}
+	return key
+}
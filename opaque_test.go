@@ -0,0 +1,59 @@
+package fastuuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestNextOpaqueUnique(t *testing.T) {
+	g := MustNewGenerator()
+	m := make(map[[24]byte]bool)
+	for i := 0; i < step; i++ {
+		uuid := g.NextOpaque()
+		if m[uuid] {
+			t.Fatalf("non-unique uuid at %d", i)
+		}
+		m[uuid] = true
+	}
+}
+
+func TestRevealOpaque(t *testing.T) {
+	g := MustNewGenerator()
+	counter := g.counter
+	for i := 0; i < 1000; i++ {
+		uuid := g.NextOpaque()
+		plain := g.RevealOpaque(uuid)
+		if got, want := binary.LittleEndian.Uint64(plain[:8]), counter+uint64(i)+1; got != want {
+			t.Fatalf("unexpected revealed counter; got %d want %d", got, want)
+		}
+		if !bytes.Equal(plain[8:], uuid[8:]) {
+			t.Fatalf("RevealOpaque changed seed bytes; got %x want %x", plain[8:], uuid[8:])
+		}
+	}
+}
+
+func TestNextOpaqueNotAdjacent(t *testing.T) {
+	g := MustNewGenerator()
+	uuid := g.NextOpaque()
+	prev := binary.LittleEndian.Uint64(uuid[:8])
+	adjacent := 0
+	for i := 0; i < 1000; i++ {
+		uuid := g.NextOpaque()
+		x := binary.LittleEndian.Uint64(uuid[:8])
+		if x == prev+1 {
+			adjacent++
+		}
+		prev = x
+	}
+	if adjacent > 1 {
+		t.Fatalf("too many adjacent opaque UUIDs: %d", adjacent)
+	}
+}
+
+func BenchmarkNextOpaque(b *testing.B) {
+	g := MustNewGenerator()
+	for i := 0; i < b.N; i++ {
+		g.NextOpaque()
+	}
+}
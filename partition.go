@@ -0,0 +1,30 @@
+package fastuuid
+
+import (
+	"encoding/binary"
+	"sync/atomic"
+)
+
+// NextInPartition is like Next except that the first byte of
+// the UUID is set to p, so that PartitionOf can be used to route
+// it. The rest of the first 8 bytes hold the low 7 bytes of the
+// counter, so UUIDs returned by NextInPartition are unique for
+// 2^56 calls rather than 2^64.
+//
+// UUIDs returned by NextInPartition may collide with those
+// returned by Next on the same generator, so the two should
+// not be mixed.
+//
+// It is OK to call this method concurrently.
+func (g *Generator) NextInPartition(p uint8) [24]byte {
+	x := atomic.AddUint64(&g.counter, g.skip+1)
+	uuid := g.loadSeed()
+	binary.LittleEndian.PutUint64(uuid[:8], x<<8|uint64(p))
+	return uuid
+}
+
+// PartitionOf returns the partition of a UUID
+// returned by Generator.NextInPartition.
+func PartitionOf(uuid [24]byte) uint8 {
+	return uuid[0]
+}
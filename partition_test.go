@@ -0,0 +1,20 @@
+package fastuuid
+
+import "testing"
+
+func TestNextInPartition(t *testing.T) {
+	g := MustNewGenerator()
+	m := make(map[[24]byte]bool)
+	for _, p := range []uint8{0, 1, 0xff} {
+		for i := 0; i < 1000; i++ {
+			uuid := g.NextInPartition(p)
+			if got := PartitionOf(uuid); got != p {
+				t.Fatalf("unexpected partition; got %d want %d", got, p)
+			}
+			if m[uuid] {
+				t.Fatalf("non-unique uuid %x", uuid)
+			}
+			m[uuid] = true
+		}
+	}
+}
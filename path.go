@@ -0,0 +1,24 @@
+package fastuuid
+
+import "path/filepath"
+
+// PathParts returns a relative file path for uuid suitable for
+// sharded on-disk storage. The path consists of depth directory
+// names, each holding the next two hex digits of the
+// HexCompact128 representation, followed by the full
+// HexCompact128 representation. For example, with a depth of 2:
+//
+//	5d/32/5d3235ebc5c046b2993eefc136ff4f1d
+//
+// It panics if depth is negative or greater than 16.
+func PathParts(uuid [24]byte, depth int) string {
+	if depth < 0 || depth > HexCompact128Len/2 {
+		panic("fastuuid: invalid depth passed to PathParts")
+	}
+	s := HexCompact128(uuid)
+	parts := make([]string, 0, depth+1)
+	for i := 0; i < depth; i++ {
+		parts = append(parts, s[i*2:i*2+2])
+	}
+	return filepath.Join(append(parts, s)...)
+}
@@ -0,0 +1,45 @@
+package fastuuid
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+var pathPartsTests = []struct {
+	depth int
+	want  string
+}{{
+	depth: 0,
+	want:  "0102030405064a0889070b0c0d0e0f10",
+}, {
+	depth: 1,
+	want:  "01/0102030405064a0889070b0c0d0e0f10",
+}, {
+	depth: 2,
+	want:  "01/02/0102030405064a0889070b0c0d0e0f10",
+}}
+
+func TestPathParts(t *testing.T) {
+	var uuid [24]byte
+	for i := range uuid {
+		uuid[i] = byte(i + 1)
+	}
+	for _, test := range pathPartsTests {
+		if got, want := PathParts(uuid, test.depth), filepath.FromSlash(test.want); got != want {
+			t.Errorf("unexpected PathParts result for depth %d; got %q want %q", test.depth, got, want)
+		}
+	}
+}
+
+func TestPathPartsInvalidDepth(t *testing.T) {
+	for _, depth := range []int{-1, 17} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for depth %d", depth)
+				}
+			}()
+			PathParts([24]byte{}, depth)
+		}()
+	}
+}
@@ -0,0 +1,67 @@
+package fastuuid
+
+// PrefixMatcher matches UUIDs against a set of byte prefixes.
+// It is built as a trie, so the cost of a match depends on
+// the length of the prefixes rather than their number.
+//
+// It is OK to call Match concurrently.
+type PrefixMatcher struct {
+	nodes []prefixNode
+}
+
+// prefixNode is a node in the trie held by PrefixMatcher.
+type prefixNode struct {
+	// next maps the next byte to the index of a child node.
+	next map[byte]int
+	// index holds the index of the prefix that ends at
+	// this node, or -1 if none does.
+	index int
+}
+
+// NewPrefixMatcher returns a PrefixMatcher for the given prefixes.
+// If the same prefix appears more than once, the first occurrence
+// is used. An empty prefix matches every UUID, and a prefix longer
+// than RawLen never matches.
+func NewPrefixMatcher(prefixes [][]byte) *PrefixMatcher {
+	m := &PrefixMatcher{
+		nodes: []prefixNode{{index: -1}},
+	}
+	for i, prefix := range prefixes {
+		n := 0
+		for _, b := range prefix {
+			child, ok := m.nodes[n].next[b]
+			if !ok {
+				if m.nodes[n].next == nil {
+					m.nodes[n].next = make(map[byte]int)
+				}
+				child = len(m.nodes)
+				m.nodes[n].next[b] = child
+				m.nodes = append(m.nodes, prefixNode{index: -1})
+			}
+			n = child
+		}
+		if m.nodes[n].index == -1 {
+			m.nodes[n].index = i
+		}
+	}
+	return m
+}
+
+// Match returns the index in the slice passed to NewPrefixMatcher
+// of the longest prefix of uuid. It returns false if
+// no prefix matches.
+func (m *PrefixMatcher) Match(uuid [24]byte) (idx int, ok bool) {
+	n := 0
+	idx = m.nodes[0].index
+	for _, b := range uuid {
+		child, found := m.nodes[n].next[b]
+		if !found {
+			break
+		}
+		n = child
+		if i := m.nodes[n].index; i >= 0 {
+			idx = i
+		}
+	}
+	return idx, idx >= 0
+}
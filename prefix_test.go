@@ -0,0 +1,105 @@
+package fastuuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+var prefixMatcherTests = []struct {
+	about    string
+	prefixes [][]byte
+	uuid     [24]byte
+	wantIdx  int
+	wantOK   bool
+}{{
+	about:    "no prefixes",
+	prefixes: nil,
+	uuid:     [24]byte{1, 2, 3},
+	wantOK:   false,
+}, {
+	about:    "single match",
+	prefixes: [][]byte{{1, 2}, {3}},
+	uuid:     [24]byte{3, 4},
+	wantIdx:  1,
+	wantOK:   true,
+}, {
+	about:    "no match",
+	prefixes: [][]byte{{1, 2}, {3}},
+	uuid:     [24]byte{1, 3},
+	wantOK:   false,
+}, {
+	about:    "longest overlapping prefix wins",
+	prefixes: [][]byte{{1}, {1, 2, 3}, {1, 2}},
+	uuid:     [24]byte{1, 2, 3, 4},
+	wantIdx:  1,
+	wantOK:   true,
+}, {
+	about:    "shorter prefix when longer does not match",
+	prefixes: [][]byte{{1}, {1, 2, 3}, {1, 2}},
+	uuid:     [24]byte{1, 2, 4},
+	wantIdx:  2,
+	wantOK:   true,
+}, {
+	about:    "empty prefix matches everything",
+	prefixes: [][]byte{{5}, {}},
+	uuid:     [24]byte{6},
+	wantIdx:  1,
+	wantOK:   true,
+}, {
+	about:    "duplicate prefix uses first",
+	prefixes: [][]byte{{7, 8}, {7, 8}},
+	uuid:     [24]byte{7, 8},
+	wantIdx:  0,
+	wantOK:   true,
+}, {
+	about:    "whole UUID",
+	prefixes: [][]byte{{23: 9}},
+	uuid:     [24]byte{23: 9},
+	wantIdx:  0,
+	wantOK:   true,
+}, {
+	about:    "prefix too long",
+	prefixes: [][]byte{{24: 0}},
+	uuid:     [24]byte{},
+	wantOK:   false,
+}}
+
+func TestPrefixMatcher(t *testing.T) {
+	for _, test := range prefixMatcherTests {
+		t.Run(test.about, func(t *testing.T) {
+			idx, ok := NewPrefixMatcher(test.prefixes).Match(test.uuid)
+			if ok != test.wantOK || (ok && idx != test.wantIdx) {
+				t.Fatalf("unexpected result; got %d, %v want %d, %v", idx, ok, test.wantIdx, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestPrefixMatcherMany(t *testing.T) {
+	g := MustNewGenerator()
+	prefixes := make([][]byte, 1000)
+	for i := range prefixes {
+		uuid := g.Next()
+		prefixes[i] = uuid[:1+i%8]
+	}
+	m := NewPrefixMatcher(prefixes)
+	for i := 0; i < 2000; i++ {
+		var uuid [24]byte
+		if i%2 == 0 {
+			copy(uuid[:], prefixes[i/2])
+		} else {
+			uuid = g.Next()
+		}
+		// Find the longest match with a linear scan.
+		wantIdx, wantLen := -1, -1
+		for j, p := range prefixes {
+			if len(p) > wantLen && bytes.HasPrefix(uuid[:], p) {
+				wantIdx, wantLen = j, len(p)
+			}
+		}
+		idx, ok := m.Match(uuid)
+		if ok != (wantIdx >= 0) || (ok && idx != wantIdx) {
+			t.Fatalf("unexpected result for %x; got %d, %v want %d", uuid, idx, ok, wantIdx)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package fastuuid
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ToProtoBytes returns the raw bytes of uuid in a newly
+// allocated slice, suitable for assigning to a protocol
+// buffer bytes field.
+//
+// This is no more than a copy of the bytes, but it gives proto
+// conversion code a named counterpart to FromProtoBytes.
+func ToProtoBytes(uuid [24]byte) []byte {
+	return append([]byte(nil), uuid[:]...)
+}
+
+// FromProtoBytes returns the UUID held in b, as returned by
+// ToProtoBytes. It returns an error if b is not exactly
+// RawLen bytes long.
+func FromProtoBytes(b []byte) ([24]byte, error) {
+	if len(b) != RawLen {
+		return [24]byte{}, errors.New("invalid UUID bytes length " + strconv.Itoa(len(b)))
+	}
+	return [24]byte(b), nil
+}
@@ -0,0 +1,35 @@
+package fastuuid
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestProtoBytes(t *testing.T) {
+	uuid := MustNewGenerator().Next()
+	b := ToProtoBytes(uuid)
+	if len(b) != RawLen {
+		t.Fatalf("unexpected length; got %d want %d", len(b), RawLen)
+	}
+	got, err := FromProtoBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != uuid {
+		t.Fatalf("round trip mismatch; got %x want %x", got, uuid)
+	}
+	// The slice must not alias anything else.
+	b[0]++
+	if got, _ := FromProtoBytes(ToProtoBytes(uuid)); got != uuid {
+		t.Fatalf("ToProtoBytes result aliases a previous result")
+	}
+}
+
+func TestFromProtoBytesError(t *testing.T) {
+	for _, n := range []int{0, 16, 23, 25} {
+		_, err := FromProtoBytes(make([]byte, n))
+		if want := "invalid UUID bytes length " + strconv.Itoa(n); err == nil || err.Error() != want {
+			t.Errorf("unexpected error; got %v want %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package fastuuid
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// qrAlphanumericLen holds the number of QR alphanumeric
+// characters needed to represent any 128 bit value.
+const qrAlphanumericLen = 24
+
+// qrAlphanumericDigits holds the 45 characters of the QR code
+// alphanumeric mode, in the order of their values in that mode.
+const qrAlphanumericDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// QRAlphanumeric returns the first 16 bytes of uuid interpreted as
+// a big-endian unsigned integer, formatted in base 45 using the
+// characters of the QR code alphanumeric mode,
+//
+//	0-9 A-Z space $ % * + - . / :
+//
+// in that order, and zero-padded to 24 characters. A QR code can
+// encode such a string in alphanumeric mode using 5.5 bits per
+// character, fewer than the 8 bits needed for each character of
+// Hex128 in byte mode. Note that the result may contain spaces.
+func QRAlphanumeric(uuid [24]byte) string {
+	return formatRadix128(uuid, qrAlphanumericDigits, qrAlphanumericLen)
+}
+
+// ParseQRAlphanumeric parses a string in the format returned by
+// QRAlphanumeric into the first 16 bytes of a UUID. The remaining
+// bytes are zero.
+func ParseQRAlphanumeric(s string) ([24]byte, error) {
+	if len(s) != qrAlphanumericLen {
+		return [24]byte{}, errors.New("invalid QR alphanumeric UUID " + strconv.Quote(s))
+	}
+	uuid, ok, inRange := parseRadix128(s, uint64(len(qrAlphanumericDigits)), fromQRAlphanumericChar)
+	if !ok {
+		return [24]byte{}, errors.New("invalid QR alphanumeric UUID " + strconv.Quote(s))
+	}
+	if !inRange {
+		return [24]byte{}, errors.New("QR alphanumeric UUID " + strconv.Quote(s) + " out of range")
+	}
+	return uuid, nil
+}
+
+func fromQRAlphanumericChar(c byte) (uint64, bool) {
+	i := strings.IndexByte(qrAlphanumericDigits, c)
+	return uint64(i), i >= 0
+}
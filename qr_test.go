@@ -0,0 +1,88 @@
+package fastuuid
+
+import "testing"
+
+var qrAlphanumericTests = []struct {
+	uuid [24]byte
+	want string
+}{{
+	uuid: [24]byte{},
+	want: "000000000000000000000000",
+}, {
+	uuid: [24]byte{15: 44},
+	want: "00000000000000000000000:",
+}, {
+	uuid: [24]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+	want: "00PUJ/INGO/GF CYWNR6P- G",
+}, {
+	uuid: [24]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	want: "39-.96C8WCHAT13F8J4Y-20U",
+}}
+
+func TestQRAlphanumeric(t *testing.T) {
+	for _, test := range qrAlphanumericTests {
+		if got := QRAlphanumeric(test.uuid); got != test.want {
+			t.Errorf("unexpected QRAlphanumeric result for %x; got %q want %q", test.uuid, got, test.want)
+		}
+		uuid, err := ParseQRAlphanumeric(test.want)
+		if err != nil {
+			t.Errorf("cannot parse %q: %v", test.want, err)
+			continue
+		}
+		if uuid != test.uuid {
+			t.Errorf("unexpected ParseQRAlphanumeric result for %q; got %x want %x", test.want, uuid, test.uuid)
+		}
+	}
+}
+
+func TestQRAlphanumericRoundTrip(t *testing.T) {
+	g := MustNewGenerator()
+	for i := 0; i < 1000; i++ {
+		uuid := g.Next()
+		s := QRAlphanumeric(uuid)
+		if len(s) != qrAlphanumericLen {
+			t.Fatalf("unexpected length of %q; got %d want %d", s, len(s), qrAlphanumericLen)
+		}
+		parsed, err := ParseQRAlphanumeric(s)
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", s, err)
+		}
+		var want [24]byte
+		copy(want[:16], uuid[:16])
+		if parsed != want {
+			t.Fatalf("round trip mismatch; got %x want %x", parsed, want)
+		}
+	}
+}
+
+var parseQRAlphanumericErrorTests = []struct {
+	s       string
+	wantErr string
+}{{
+	s:       "",
+	wantErr: `invalid QR alphanumeric UUID ""`,
+}, {
+	s:       "00000000000000000000000",
+	wantErr: `invalid QR alphanumeric UUID "00000000000000000000000"`,
+}, {
+	s:       "00000000000000000000000a",
+	wantErr: `invalid QR alphanumeric UUID "00000000000000000000000a"`,
+}, {
+	s:       "39-.96C8WCHAT13F8J4Y-20V",
+	wantErr: `QR alphanumeric UUID "39-.96C8WCHAT13F8J4Y-20V" out of range`,
+}, {
+	s:       "::::::::::::::::::::::::",
+	wantErr: `QR alphanumeric UUID "::::::::::::::::::::::::" out of range`,
+}}
+
+func TestParseQRAlphanumericError(t *testing.T) {
+	for _, test := range parseQRAlphanumericErrorTests {
+		uuid, err := ParseQRAlphanumeric(test.s)
+		if err == nil || err.Error() != test.wantErr {
+			t.Errorf("unexpected error for %q; got %v want %q", test.s, err, test.wantErr)
+		}
+		if uuid != [24]byte{} {
+			t.Errorf("non-zero UUID returned on error for %q", test.s)
+		}
+	}
+}
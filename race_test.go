@@ -0,0 +1,7 @@
+//go:build race
+
+package fastuuid
+
+// raceEnabled reports whether the race detector is enabled.
+// In that case sync.Pool drops items at random.
+const raceEnabled = true
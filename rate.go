@@ -0,0 +1,29 @@
+package fastuuid
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// Rate measures the rate at which UUIDs are being generated by g,
+// in UUIDs per second, by sampling the counter twice, window
+// apart. It blocks for the duration of the window but does not
+// affect concurrent calls to Next.
+func (g *Generator) Rate(window time.Duration) (float64, error) {
+	if window <= 0 {
+		return 0, errors.New("non-positive window passed to Rate")
+	}
+	start := time.Now()
+	c0 := atomic.LoadUint64(&g.counter)
+	time.Sleep(window)
+	c1 := atomic.LoadUint64(&g.counter)
+	elapsed := time.Since(start)
+	step := g.skip + 1
+	n := (c1 - c0) / step
+	if step == ^uint64(0) {
+		// The counter is descending.
+		n = c0 - c1
+	}
+	return float64(n) / elapsed.Seconds(), nil
+}
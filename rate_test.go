@@ -0,0 +1,64 @@
+package fastuuid
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRate(t *testing.T) {
+	for _, newGenerator := range []func() (*Generator, error){
+		NewGenerator,
+		NewDescendingGenerator,
+		func() (*Generator, error) {
+			return NewStridedGenerator(0, 1000)
+		},
+	} {
+		g, err := newGenerator()
+		if err != nil {
+			t.Fatalf("cannot make generator: %v", err)
+		}
+		testRate(t, g)
+	}
+}
+
+func testRate(t *testing.T, g *Generator) {
+	rate, err := g.Rate(10 * time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate != 0 {
+		t.Fatalf("unexpected rate for idle generator; got %v want 0", rate)
+	}
+	var stop, total int64
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for atomic.LoadInt64(&stop) == 0 {
+			g.Next()
+			atomic.AddInt64(&total, 1)
+			time.Sleep(10 * time.Microsecond)
+		}
+	}()
+	const window = 50 * time.Millisecond
+	start := time.Now()
+	rate, err = g.Rate(window)
+	elapsed := time.Since(start)
+	atomic.StoreInt64(&stop, 1)
+	<-done
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate <= 0 {
+		t.Fatalf("unexpected rate; got %v want > 0", rate)
+	}
+	if max := float64(total) / window.Seconds(); rate > max {
+		t.Fatalf("implausible rate %v; only %d UUIDs generated in %v", rate, total, elapsed)
+	}
+}
+
+func TestRateInvalidWindow(t *testing.T) {
+	if _, err := MustNewGenerator().Rate(0); err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -0,0 +1,64 @@
+package fastuuid
+
+import "sync"
+
+// RecentGenerator wraps a Generator, remembering the most
+// recently generated UUIDs. This can be useful when debugging
+// problems such as the same UUID being assigned twice.
+//
+// It retains K*24 bytes of UUIDs, where K is the number
+// passed to NewRecentGenerator.
+type RecentGenerator struct {
+	g *Generator
+
+	mu   sync.Mutex
+	ring []UUID
+	// next holds the index in ring of the next
+	// UUID to be stored.
+	next int
+	// full reports whether ring has wrapped around.
+	full bool
+}
+
+// NewRecentGenerator returns a RecentGenerator that generates
+// UUIDs with g and remembers the last k of them.
+// It panics if k <= 0.
+func NewRecentGenerator(g *Generator, k int) *RecentGenerator {
+	if k <= 0 {
+		panic("fastuuid: invalid argument to NewRecentGenerator")
+	}
+	return &RecentGenerator{
+		g:    g,
+		ring: make([]UUID, k),
+	}
+}
+
+// Next returns the next UUID from the underlying generator
+// and records it.
+//
+// It is OK to call this method concurrently.
+func (r *RecentGenerator) Next() [24]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uuid := r.g.Next()
+	r.ring[r.next] = uuid
+	r.next++
+	if r.next == len(r.ring) {
+		r.next = 0
+		r.full = true
+	}
+	return uuid
+}
+
+// Recent returns the most recently generated UUIDs,
+// oldest first. It returns at most k UUIDs.
+func (r *RecentGenerator) Recent() []UUID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return append([]UUID(nil), r.ring[:r.next]...)
+	}
+	uuids := make([]UUID, 0, len(r.ring))
+	uuids = append(uuids, r.ring[r.next:]...)
+	return append(uuids, r.ring[:r.next]...)
+}
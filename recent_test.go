@@ -0,0 +1,45 @@
+package fastuuid
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRecentGenerator(t *testing.T) {
+	const k = 5
+	r := NewRecentGenerator(MustNewGenerator(), k)
+	if got := r.Recent(); len(got) != 0 {
+		t.Fatalf("unexpected initial Recent result: %x", got)
+	}
+	var all []UUID
+	for i := 0; i < 12; i++ {
+		all = append(all, r.Next())
+		want := all
+		if len(want) > k {
+			want = want[len(want)-k:]
+		}
+		if got := r.Recent(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("unexpected Recent result after %d calls; got %x want %x", i+1, got, want)
+		}
+	}
+}
+
+func TestRecentGeneratorConcurrent(t *testing.T) {
+	r := NewRecentGenerator(MustNewGenerator(), 10)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				r.Next()
+				r.Recent()
+			}
+		}()
+	}
+	wg.Wait()
+	if got := len(r.Recent()); got != 10 {
+		t.Fatalf("unexpected Recent length; got %d want 10", got)
+	}
+}
@@ -0,0 +1,16 @@
+package fastuuid
+
+import "iter"
+
+// Seq returns an iterator over the next n UUIDs from g.
+// Each UUID is generated as it is requested, so stopping
+// the iteration early does not consume the remaining UUIDs.
+func (g *Generator) Seq(n int) iter.Seq[[24]byte] {
+	return func(yield func([24]byte) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(g.Next()) {
+				return
+			}
+		}
+	}
+}
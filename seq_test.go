@@ -0,0 +1,32 @@
+package fastuuid
+
+import "testing"
+
+func TestSeq(t *testing.T) {
+	g := MustNewGenerator()
+	m := make(map[[24]byte]bool)
+	for uuid := range g.Seq(1000) {
+		if m[uuid] {
+			t.Fatalf("non-unique uuid %x", uuid)
+		}
+		m[uuid] = true
+	}
+	if len(m) != 1000 {
+		t.Fatalf("unexpected uuid count; got %d want 1000", len(m))
+	}
+}
+
+func TestSeqBreak(t *testing.T) {
+	g := MustNewGenerator()
+	counter := g.counter
+	n := 0
+	for range g.Seq(1000) {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	if got, want := g.counter, counter+10; got != want {
+		t.Fatalf("unexpected counter after break; got %d want %d", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package fastuuid
+
+import "testing"
+
+func TestSignedGenerator(t *testing.T) {
+	s, err := NewSignedGenerator([]byte("secret"))
+	if err != nil {
+		t.Fatalf("cannot make generator: %v", err)
+	}
+	other, err := NewSignedGenerator([]byte("other secret"))
+	if err != nil {
+		t.Fatalf("cannot make generator: %v", err)
+	}
+	m := make(map[[24]byte]bool)
+	for i := 0; i < 100; i++ {
+		uuid := s.Next()
+		if m[uuid] {
+			t.Fatalf("non-unique uuid %x", uuid)
+		}
+		m[uuid] = true
+		if !s.Verify(uuid) {
+			t.Fatalf("genuine UUID %x does not verify", uuid)
+		}
+		if other.Verify(uuid) {
+			t.Fatalf("UUID %x verifies with a different key", uuid)
+		}
+		for _, i := range []int{0, 7, 8, 15, 16, 23} {
+			tampered := uuid
+			tampered[i] ^= 1
+			if s.Verify(tampered) {
+				t.Fatalf("UUID tampered at byte %d verifies", i)
+			}
+		}
+	}
+}
+
+func TestNewSignedGeneratorEmptyKey(t *testing.T) {
+	if _, err := NewSignedGenerator(nil); err == nil {
+		t.Fatalf("expected error")
+	}
+}
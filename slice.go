@@ -0,0 +1,140 @@
+package fastuuid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+)
+
+// Unique reports whether all the given UUIDs are distinct.
+// If they are not, it also returns the first UUID found
+// that duplicates an earlier one.
+func Unique(uuids []UUID) (bool, UUID) {
+	seen := make(map[UUID]struct{}, len(uuids))
+	for _, uuid := range uuids {
+		if _, ok := seen[uuid]; ok {
+			return false, uuid
+		}
+		seen[uuid] = struct{}{}
+	}
+	return true, UUID{}
+}
+
+// ParseHexLines parses each of the given strings with ParseHex128.
+// It returns the parsed UUIDs and the corresponding errors;
+// for each index, the error is nil if and only if the string
+// at that index was parsed successfully.
+func ParseHexLines(ss []string) ([]UUID, []error) {
+	uuids := make([]UUID, len(ss))
+	errs := make([]error, len(ss))
+	for i, s := range ss {
+		uuids[i], errs[i] = ParseHex128(s)
+	}
+	return uuids, errs
+}
+
+// ByteHistogram returns the number of times each byte value
+// occurs at the given index in the given UUIDs. This can be
+// used to check how evenly distributed the bytes are.
+//
+// It panics if index is not in the range [0, 24).
+func ByteHistogram(uuids []UUID, index int) [256]int {
+	if index < 0 || index >= RawLen {
+		panic("fastuuid: invalid index passed to ByteHistogram")
+	}
+	var counts [256]int
+	for _, uuid := range uuids {
+		counts[uuid[index]]++
+	}
+	return counts
+}
+
+// ShortestUnique returns, for each of the given UUIDs, the
+// shortest prefix of its hex encoding that is not a prefix of the
+// hex encoding of any other UUID in the slice, in the same way that
+// git abbreviates commit hashes. The hex encoding used covers all
+// 24 bytes with no rewriting, and starts with the same 12 digits
+// as Hex128 and HexCompact128.
+//
+// Duplicate UUIDs share a single entry in the result.
+func ShortestUnique(uuids []UUID) map[UUID]string {
+	hexes := make([]string, 0, len(uuids))
+	result := make(map[UUID]string, len(uuids))
+	for _, uuid := range uuids {
+		if _, ok := result[uuid]; ok {
+			continue
+		}
+		result[uuid] = ""
+		hexes = append(hexes, hex.EncodeToString(uuid[:]))
+	}
+	sort.Strings(hexes)
+	for i, h := range hexes {
+		n := 0
+		if i > 0 {
+			n = commonPrefixLen(h, hexes[i-1])
+		}
+		if i < len(hexes)-1 {
+			if m := commonPrefixLen(h, hexes[i+1]); m > n {
+				n = m
+			}
+		}
+		var uuid UUID
+		hex.Decode(uuid[:], []byte(h))
+		result[uuid] = h[:n+1]
+	}
+	return result
+}
+
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// MergeSorted returns a new slice holding the UUIDs of a and b
+// in ascending order, as compared by bytes.Compare. Both a and b
+// must already be sorted in that order; if they are not, the
+// result is not sorted either. UUIDs present in both slices
+// appear twice in the result.
+func MergeSorted(a, b []UUID) []UUID {
+	merged := make([]UUID, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if bytes.Compare(b[0][:], a[0][:]) < 0 {
+			merged = append(merged, b[0])
+			b = b[1:]
+		} else {
+			merged = append(merged, a[0])
+			a = a[1:]
+		}
+	}
+	merged = append(merged, a...)
+	return append(merged, b...)
+}
+
+// Pack returns the given UUIDs concatenated into a single
+// byte slice of length len(uuids)*RawLen.
+func Pack(uuids []UUID) []byte {
+	b := make([]byte, 0, len(uuids)*RawLen)
+	for _, uuid := range uuids {
+		b = append(b, uuid[:]...)
+	}
+	return b
+}
+
+// Unpack splits a byte slice in the format returned by Pack
+// back into UUIDs. It returns an error if the length of b
+// is not a multiple of RawLen.
+func Unpack(b []byte) ([]UUID, error) {
+	if len(b)%RawLen != 0 {
+		return nil, errors.New("invalid packed UUID length " + strconv.Itoa(len(b)))
+	}
+	uuids := make([]UUID, len(b)/RawLen)
+	for i := range uuids {
+		uuids[i] = UUID(b[i*RawLen:])
+	}
+	return uuids, nil
+}
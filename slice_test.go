@@ -0,0 +1,246 @@
+package fastuuid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var uniqueTests = []struct {
+	about    string
+	uuids    []UUID
+	unique   bool
+	firstDup UUID
+}{{
+	about:  "empty",
+	unique: true,
+}, {
+	about:  "distinct",
+	uuids:  []UUID{{1}, {2}, {3}},
+	unique: true,
+}, {
+	about:    "one duplicate",
+	uuids:    []UUID{{1}, {2}, {1}},
+	firstDup: UUID{1},
+}, {
+	about:    "several duplicates",
+	uuids:    []UUID{{1}, {2}, {3}, {3}, {2}},
+	firstDup: UUID{3},
+}}
+
+func TestUnique(t *testing.T) {
+	for _, test := range uniqueTests {
+		t.Run(test.about, func(t *testing.T) {
+			unique, dup := Unique(test.uuids)
+			if unique != test.unique || dup != test.firstDup {
+				t.Fatalf("unexpected Unique result; got %v, %x want %v, %x", unique, dup, test.unique, test.firstDup)
+			}
+		})
+	}
+}
+
+func TestParseHexLines(t *testing.T) {
+	ss := []string{
+		"01020304-0506-0708-090a-0b0c0d0e0f10",
+		"not a uuid",
+		"",
+		"a1a2a3a4-a5a6-a7a8-a9aa-abacadaeafb0",
+	}
+	uuids, errs := ParseHexLines(ss)
+	if len(uuids) != len(ss) || len(errs) != len(ss) {
+		t.Fatalf("unexpected result lengths; got %d, %d want %d", len(uuids), len(errs), len(ss))
+	}
+	for i, s := range ss {
+		want, wantErr := ParseHex128(s)
+		if (errs[i] == nil) != (wantErr == nil) {
+			t.Errorf("unexpected error at %d; got %v want %v", i, errs[i], wantErr)
+		}
+		if uuids[i] != want {
+			t.Errorf("unexpected UUID at %d; got %x want %x", i, uuids[i], want)
+		}
+	}
+	if errs[0] != nil || errs[1] == nil || errs[2] == nil || errs[3] != nil {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+
+func TestByteHistogram(t *testing.T) {
+	uuids := []UUID{{1, 2}, {1, 3}, {2, 3}}
+	counts := ByteHistogram(uuids, 1)
+	if counts[2] != 1 || counts[3] != 2 {
+		t.Fatalf("unexpected histogram counts %d, %d", counts[2], counts[3])
+	}
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	if total != len(uuids) {
+		t.Fatalf("unexpected histogram total; got %d want %d", total, len(uuids))
+	}
+}
+
+func TestByteHistogramOpaqueFlat(t *testing.T) {
+	g := MustNewGenerator()
+	const perValue = 200
+	uuids := make([]UUID, 256*perValue)
+	for i := range uuids {
+		uuids[i] = g.NextOpaque()
+	}
+	for index := 0; index < 8; index++ {
+		for b, n := range ByteHistogram(uuids, index) {
+			if n < perValue/2 || n > perValue*3/2 {
+				t.Errorf("uneven histogram at index %d; value %#x occurs %d times, want about %d", index, b, n, perValue)
+			}
+		}
+	}
+}
+
+func TestByteHistogramInvalidIndex(t *testing.T) {
+	for _, index := range []int{-1, 24} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for index %d", index)
+				}
+			}()
+			ByteHistogram(nil, index)
+		}()
+	}
+}
+
+func TestShortestUnique(t *testing.T) {
+	g := MustNewGenerator()
+	uuids := make([]UUID, 1000)
+	for i := range uuids {
+		uuids[i] = g.Next()
+	}
+	// Add some UUIDs that differ only in the last byte
+	// and a duplicate.
+	last := uuids[len(uuids)-1]
+	last[23] ^= 1
+	uuids = append(uuids, last, last)
+	prefixes := ShortestUnique(uuids)
+	if len(prefixes) != len(uuids)-1 {
+		t.Fatalf("unexpected result size; got %d want %d", len(prefixes), len(uuids)-1)
+	}
+	seen := make(map[string]bool)
+	for uuid, prefix := range prefixes {
+		if seen[prefix] {
+			t.Fatalf("duplicate prefix %q", prefix)
+		}
+		seen[prefix] = true
+		matches := 0
+		for other := range prefixes {
+			if strings.HasPrefix(hex.EncodeToString(other[:]), prefix) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			t.Fatalf("prefix %q of %x matches %d UUIDs", prefix, uuid, matches)
+		}
+		// A shorter prefix must not be unique.
+		if len(prefix) > 1 {
+			shorter := prefix[:len(prefix)-1]
+			matches := 0
+			for other := range prefixes {
+				if strings.HasPrefix(hex.EncodeToString(other[:]), shorter) {
+					matches++
+				}
+			}
+			if matches == 1 {
+				t.Fatalf("prefix %q of %x is not the shortest", prefix, uuid)
+			}
+		}
+	}
+	if got := prefixes[last]; len(got) != 48 {
+		t.Fatalf("unexpected prefix length for near-duplicate; got %q", got)
+	}
+	if got := ShortestUnique([]UUID{{0xab}}); got[UUID{0xab}] != "a" {
+		t.Fatalf("unexpected prefix for single UUID: %q", got[UUID{0xab}])
+	}
+}
+
+var mergeSortedTests = []struct {
+	about string
+	a, b  []UUID
+	want  []UUID
+}{{
+	about: "both empty",
+	want:  []UUID{},
+}, {
+	about: "one empty",
+	a:     []UUID{{1}, {2}},
+	want:  []UUID{{1}, {2}},
+}, {
+	about: "disjoint ranges",
+	a:     []UUID{{5}, {6}},
+	b:     []UUID{{1}, {2}, {3}},
+	want:  []UUID{{1}, {2}, {3}, {5}, {6}},
+}, {
+	about: "overlapping ranges",
+	a:     []UUID{{1}, {3}, {5}, {7}},
+	b:     []UUID{{2}, {3}, {4}, {8}},
+	want:  []UUID{{1}, {2}, {3}, {3}, {4}, {5}, {7}, {8}},
+}, {
+	about: "difference in last byte",
+	a:     []UUID{{23: 2}},
+	b:     []UUID{{23: 1}, {23: 3}},
+	want:  []UUID{{23: 1}, {23: 2}, {23: 3}},
+}}
+
+func TestMergeSorted(t *testing.T) {
+	for _, test := range mergeSortedTests {
+		t.Run(test.about, func(t *testing.T) {
+			for _, s := range [][]UUID{test.a, test.b} {
+				if !sort.SliceIsSorted(s, func(i, j int) bool {
+					return bytes.Compare(s[i][:], s[j][:]) < 0
+				}) {
+					t.Fatalf("test input %x is not sorted", s)
+				}
+			}
+			got := MergeSorted(test.a, test.b)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("unexpected result; got %x want %x", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPack(t *testing.T) {
+	g := MustNewGenerator()
+	for _, n := range []int{0, 1, 10} {
+		uuids := make([]UUID, n)
+		for i := range uuids {
+			uuids[i] = g.Next()
+		}
+		b := Pack(uuids)
+		if len(b) != n*RawLen {
+			t.Fatalf("unexpected packed length; got %d want %d", len(b), n*RawLen)
+		}
+		for i, uuid := range uuids {
+			if !bytes.Equal(b[i*RawLen:(i+1)*RawLen], uuid[:]) {
+				t.Fatalf("unexpected bytes for UUID %d", i)
+			}
+		}
+		got, err := Unpack(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != n || (n > 0 && !reflect.DeepEqual(got, uuids)) {
+			t.Fatalf("unexpected Unpack result; got %x want %x", got, uuids)
+		}
+	}
+}
+
+func TestUnpackError(t *testing.T) {
+	for _, n := range []int{1, 23, 25, 47} {
+		_, err := Unpack(make([]byte, n))
+		if want := "invalid packed UUID length " + strconv.Itoa(n); err == nil || err.Error() != want {
+			t.Errorf("unexpected error; got %v want %q", err, want)
+		}
+	}
+}
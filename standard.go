@@ -0,0 +1,35 @@
+package fastuuid
+
+// FromStandard returns a UUID that embeds the standard
+// 128 bit UUID u. The 16 bytes of u are placed in the
+// first 16 bytes of the result, so that Hex128 and Standard
+// operate on them, and the last 8 bytes are filled
+// with the varying bytes of g.Next. If g is nil, the last
+// 8 bytes are zero.
+//
+// Standard can be used to retrieve u.
+func FromStandard(u [16]byte, g *Generator) [24]byte {
+	var uuid [24]byte
+	copy(uuid[:16], u[:])
+	if g != nil {
+		next := g.Next()
+		copy(uuid[16:], next[:8])
+	}
+	return uuid
+}
+
+// Standard returns the first 16 bytes of uuid.
+// This is the inverse of FromStandard.
+func (uuid UUID) Standard() [16]byte {
+	var u [16]byte
+	copy(u[:], uuid[:16])
+	return u
+}
+
+// TryTo128 returns the first 16 bytes of uuid and reports
+// whether doing so loses no information, which is the case
+// only when the last 8 bytes are all zero.
+func (uuid UUID) TryTo128() ([16]byte, bool) {
+	u := uuid.Standard()
+	return u, [8]byte(uuid[16:]) == [8]byte{}
+}
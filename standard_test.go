@@ -0,0 +1,40 @@
+package fastuuid
+
+import "testing"
+
+func TestFromStandard(t *testing.T) {
+	u := [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	g := MustNewGenerator()
+	uuid0 := FromStandard(u, g)
+	if got := UUID(uuid0).Standard(); got != u {
+		t.Fatalf("unexpected Standard result; got %x want %x", got, u)
+	}
+	uuid1 := FromStandard(u, g)
+	if uuid0 == uuid1 {
+		t.Fatalf("FromStandard returned the same UUID twice: %x", uuid0)
+	}
+	uuid := FromStandard(u, nil)
+	if want := [24]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}; uuid != want {
+		t.Fatalf("unexpected FromStandard result with nil generator; got %x want %x", uuid, want)
+	}
+}
+
+func TestTryTo128(t *testing.T) {
+	u := [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	got, ok := UUID(FromStandard(u, nil)).TryTo128()
+	if !ok {
+		t.Fatalf("unexpected lossy conversion of %x", u)
+	}
+	if got != u {
+		t.Fatalf("unexpected TryTo128 result; got %x want %x", got, u)
+	}
+	uuid := UUID(FromStandard(u, nil))
+	uuid[23] = 1
+	got, ok = uuid.TryTo128()
+	if ok {
+		t.Fatalf("unexpected lossless conversion of %x", uuid)
+	}
+	if got != u {
+		t.Fatalf("unexpected TryTo128 result; got %x want %x", got, u)
+	}
+}
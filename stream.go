@@ -0,0 +1,27 @@
+package fastuuid
+
+import "context"
+
+// Stream returns a channel with the given buffer size that
+// delivers UUIDs from g. UUIDs are generated by a goroutine
+// that runs until ctx is cancelled, at which point it closes
+// the channel and exits. Some UUIDs may remain buffered in the
+// channel after cancellation.
+//
+// The UUIDs are unique, but when several goroutines receive from
+// the channel, a receiver is not guaranteed to see them in
+// counter order.
+func (g *Generator) Stream(ctx context.Context, buffer int) <-chan [24]byte {
+	c := make(chan [24]byte, buffer)
+	go func() {
+		defer close(c)
+		for {
+			select {
+			case c <- g.Next():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return c
+}
@@ -0,0 +1,41 @@
+package fastuuid
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStream(t *testing.T) {
+	g := MustNewGenerator()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := g.Stream(ctx, 10)
+	seen := make(map[[24]byte]bool)
+	for i := 0; i < 1000; i++ {
+		uuid := <-c
+		if seen[uuid] {
+			t.Fatalf("duplicate UUID %x", uuid)
+		}
+		seen[uuid] = true
+	}
+}
+
+func TestStreamCancel(t *testing.T) {
+	g := MustNewGenerator()
+	ctx, cancel := context.WithCancel(context.Background())
+	c := g.Stream(ctx, 10)
+	<-c
+	cancel()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-c:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("channel not closed after cancellation")
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package fastuuid
+
+import "testing"
+
+func TestNextTagged(t *testing.T) {
+	g := MustNewGenerator()
+	seen := make(map[[24]byte]bool)
+	for _, tag := range []uint16{0, 1, 0x1234, 0xffff} {
+		for i := 0; i < 100; i++ {
+			uuid := g.NextTagged(tag)
+			if got := TagOf(uuid); got != tag {
+				t.Fatalf("unexpected tag; got %#x want %#x", got, tag)
+			}
+			if seen[uuid] {
+				t.Fatalf("duplicate UUID %x", uuid)
+			}
+			seen[uuid] = true
+		}
+	}
+	// The rest of the seed tail is unaffected.
+	uuid, tagged := g.Next(), g.NextTagged(1)
+	if [14]byte(uuid[8:22]) != [14]byte(tagged[8:22]) {
+		t.Fatalf("unexpected seed tail; got %x want %x", tagged[8:22], uuid[8:22])
+	}
+}
@@ -0,0 +1,64 @@
+package fastuuid
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Uint128 represents an unsigned 128 bit integer.
+type Uint128 struct {
+	Hi, Lo uint64
+}
+
+// Uint128 returns the first 16 bytes of uuid
+// interpreted as a big-endian integer.
+func (uuid UUID) Uint128() Uint128 {
+	return Uint128{
+		Hi: binary.BigEndian.Uint64(uuid[0:8]),
+		Lo: binary.BigEndian.Uint64(uuid[8:16]),
+	}
+}
+
+// FromUint128 returns a UUID with its first 16 bytes
+// holding v in big-endian order. The remaining
+// bytes are zero.
+func FromUint128(v Uint128) UUID {
+	var uuid UUID
+	binary.BigEndian.PutUint64(uuid[0:8], v.Hi)
+	binary.BigEndian.PutUint64(uuid[8:16], v.Lo)
+	return uuid
+}
+
+// Add returns uuid with n added to its first 16 bytes
+// interpreted as a big-endian integer, for example to compute
+// the next n UUIDs in a block starting at uuid. Carries
+// propagate across all 16 bytes; a result of 2^128 or more
+// wraps around modulo 2^128. The last 8 bytes are unchanged.
+func (uuid UUID) Add(n uint64) UUID {
+	v := uuid.Uint128()
+	var carry uint64
+	v.Lo, carry = bits.Add64(v.Lo, n, 0)
+	v.Hi += carry
+	binary.BigEndian.PutUint64(uuid[0:8], v.Hi)
+	binary.BigEndian.PutUint64(uuid[8:16], v.Lo)
+	return uuid
+}
+
+// Midpoint returns the UUID halfway between lo and hi, treating
+// their first 16 bytes as big-endian integers and rounding down,
+// for example to split a range of keys in two. The sum is computed
+// with a carry bit so that large values do not overflow. The last
+// 8 bytes of the result are zero.
+//
+// If lo <= hi, the result lies in [lo, hi] when compared on the
+// first 16 bytes.
+func Midpoint(lo, hi [24]byte) [24]byte {
+	a, b := UUID(lo).Uint128(), UUID(hi).Uint128()
+	l, carry := bits.Add64(a.Lo, b.Lo, 0)
+	h, carry := bits.Add64(a.Hi, b.Hi, carry)
+	// Shift the 129 bit sum carry:h:l right by one.
+	return FromUint128(Uint128{
+		Hi: carry<<63 | h>>1,
+		Lo: h<<63 | l>>1,
+	})
+}
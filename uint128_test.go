@@ -0,0 +1,146 @@
+package fastuuid
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"math/bits"
+	"testing"
+)
+
+func TestUint128(t *testing.T) {
+	uuid := UUID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	v := uuid.Uint128()
+	if want := (Uint128{Hi: 0x0102030405060708, Lo: 0x090a0b0c0d0e0f10}); v != want {
+		t.Fatalf("unexpected Uint128 result; got %#v want %#v", v, want)
+	}
+	if got := FromUint128(v); got != uuid {
+		t.Fatalf("unexpected FromUint128 result; got %x want %x", got, uuid)
+	}
+}
+
+func TestUint128Increment(t *testing.T) {
+	uuid := UUID{7: 1, 8: 0xff, 9: 0xff, 10: 0xff, 11: 0xff, 12: 0xff, 13: 0xff, 14: 0xff, 15: 0xff}
+	v := uuid.Uint128()
+	var carry uint64
+	v.Lo, carry = bits.Add64(v.Lo, 1, 0)
+	v.Hi += carry
+	if got, want := FromUint128(v), (UUID{7: 2}); got != want {
+		t.Fatalf("unexpected incremented UUID; got %x want %x", got, want)
+	}
+}
+
+var addTests = []struct {
+	about string
+	uuid  UUID
+	n     uint64
+	want  UUID
+}{{
+	about: "zero",
+	uuid:  UUID{15: 5, 23: 9},
+	n:     0,
+	want:  UUID{15: 5, 23: 9},
+}, {
+	about: "no carry",
+	uuid:  UUID{15: 5},
+	n:     10,
+	want:  UUID{15: 15},
+}, {
+	about: "carry into next byte",
+	uuid:  UUID{15: 0xff},
+	n:     1,
+	want:  UUID{14: 1},
+}, {
+	about: "carry into high word",
+	uuid:  UUID{7: 1, 8: 0xff, 9: 0xff, 10: 0xff, 11: 0xff, 12: 0xff, 13: 0xff, 14: 0xff, 15: 0xfe},
+	n:     3,
+	want:  UUID{7: 2, 15: 1},
+}, {
+	about: "large n",
+	uuid:  UUID{15: 1},
+	n:     0xffffffffffffffff,
+	want:  UUID{7: 1},
+}, {
+	about: "overflow wraps",
+	uuid:  UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 23: 7},
+	n:     2,
+	want:  UUID{15: 1, 23: 7},
+}}
+
+func TestAdd(t *testing.T) {
+	for _, test := range addTests {
+		t.Run(test.about, func(t *testing.T) {
+			if got := test.uuid.Add(test.n); got != test.want {
+				t.Fatalf("unexpected result; got %x want %x", got, test.want)
+			}
+		})
+	}
+}
+
+var midpointTests = []struct {
+	about  string
+	lo, hi UUID
+	want   UUID
+}{{
+	about: "equal",
+	lo:    UUID{15: 4},
+	hi:    UUID{15: 4},
+	want:  UUID{15: 4},
+}, {
+	about: "round down",
+	lo:    UUID{15: 4},
+	hi:    UUID{15: 7},
+	want:  UUID{15: 5},
+}, {
+	about: "low word sum overflows",
+	lo:    UUID{8: 0x80},
+	hi:    UUID{8: 0x80},
+	want:  UUID{8: 0x80},
+}, {
+	about: "odd high word",
+	lo:    UUID{},
+	hi:    UUID{7: 1},
+	want:  UUID{8: 0x80},
+}, {
+	about: "largest values",
+	lo:    UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+	hi:    UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	want:  UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+}, {
+	about: "tail ignored",
+	lo:    UUID{15: 2, 23: 0xff},
+	hi:    UUID{15: 4, 16: 0xff},
+	want:  UUID{15: 3},
+}}
+
+func TestMidpoint(t *testing.T) {
+	for _, test := range midpointTests {
+		t.Run(test.about, func(t *testing.T) {
+			if got := Midpoint(test.lo, test.hi); got != test.want {
+				t.Fatalf("unexpected result; got %x want %x", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMidpointRandom(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		var lo, hi [24]byte
+		rand.Read(lo[:16])
+		rand.Read(hi[:16])
+		if bytes.Compare(lo[:16], hi[:16]) > 0 {
+			lo, hi = hi, lo
+		}
+		mid := Midpoint(lo, hi)
+		if bytes.Compare(mid[:16], lo[:16]) < 0 || bytes.Compare(mid[:16], hi[:16]) > 0 {
+			t.Fatalf("midpoint %x not between %x and %x", mid, lo, hi)
+		}
+		// Check against math/big.
+		sum := new(big.Int).Add(new(big.Int).SetBytes(lo[:16]), new(big.Int).SetBytes(hi[:16]))
+		var want [24]byte
+		sum.Rsh(sum, 1).FillBytes(want[:16])
+		if mid != want {
+			t.Fatalf("unexpected midpoint of %x and %x; got %x want %x", lo, hi, mid, want)
+		}
+	}
+}
@@ -26,17 +26,84 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"strconv"
+	"strings"
 	"sync/atomic"
+	"unsafe"
 )
 
+// UUID represents a 192 bit UUID as returned by Generator.Next.
+type UUID [24]byte
+
+// Lengths of the various UUID representations.
+const (
+	// RawLen holds the length in bytes of a raw UUID.
+	RawLen = 24
+
+	// Hex128Len holds the length of the dashed 128 bit
+	// hex form returned by Hex128.
+	Hex128Len = 36
+
+	// HexCompact128Len holds the length of the 128 bit
+	// hex form without dashes.
+	HexCompact128Len = 32
+
+	// Base64Len holds the length of the unpadded base64
+	// encoding of a raw UUID.
+	Base64Len = 32
+)
+
+// AsUUID returns b as a *UUID so that UUID methods can be
+// called on it without copying. The result aliases b, so
+// changes made through either pointer are visible through
+// the other.
+func AsUUID(b *[24]byte) *UUID {
+	return (*UUID)(b)
+}
+
+// IsZero reports whether all the bytes of uuid are zero.
+func (uuid UUID) IsZero() bool {
+	return binary.LittleEndian.Uint64(uuid[0:8])|
+		binary.LittleEndian.Uint64(uuid[8:16])|
+		binary.LittleEndian.Uint64(uuid[16:24]) == 0
+}
+
+// Source is implemented by types that generate UUIDs,
+// such as *Generator. Code that needs UUIDs can depend
+// on a Source so that tests can supply predictable values.
+type Source interface {
+	Next() [24]byte
+}
+
 // Generator represents a UUID generator that
 // generates UUIDs in sequence from a random starting
 // point.
+//
+// The zero value is a usable generator that counts upwards from
+// zero with an all-zero seed, which is only useful for testing.
 type Generator struct {
+	// counter is first so that it is 64-bit aligned
+	// for atomic operations on 32-bit platforms.
+	counter uint64
+
+	// skip holds one less than the amount added to the
+	// counter for each UUID generated, so that the zero
+	// Generator counts upwards by one.
+	skip uint64
+
 	// The constant seed. The first 8 bytes of this are
 	// copied into counter and then ignored thereafter.
-	seed    [24]byte
-	counter uint64
+	seed [24]byte
+
+	// tail holds a *[16]byte that replaces the last 16
+	// bytes of seed once SetSeedTail has been called.
+	// It is accessed atomically.
+	tail unsafe.Pointer
+
+	// opaque holds the *opaqueKey used by NextOpaque,
+	// or nil if it has not been used yet. It is accessed
+	// atomically.
+	opaque unsafe.Pointer
 }
 
 // NewGenerator returns a new Generator.
@@ -51,6 +118,85 @@ func NewGenerator() (*Generator, error) {
 	return &g, nil
 }
 
+// NewGeneratorChecked is like NewGenerator but also
+// checks that the random seed looks plausibly random,
+// returning an error if it does not. This can catch
+// a badly misconfigured random number source at startup
+// rather than through colliding UUIDs later.
+//
+// The check is a heuristic: it rejects seeds that are all
+// zero or contain fewer than minSeedDistinctBytes distinct
+// byte values, which a truly random seed will do with
+// negligible probability.
+func NewGeneratorChecked() (*Generator, error) {
+	g, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	seed := g.seed
+	if seed == ([24]byte{}) {
+		return nil, errors.New("random seed is all zero")
+	}
+	var seen [256]bool
+	distinct := 0
+	for _, b := range seed {
+		if !seen[b] {
+			seen[b] = true
+			distinct++
+		}
+	}
+	if distinct < minSeedDistinctBytes {
+		return nil, errors.New("random seed has suspiciously low entropy")
+	}
+	return g, nil
+}
+
+// minSeedDistinctBytes holds the minimum number of distinct
+// byte values accepted in a seed by NewGeneratorChecked.
+const minSeedDistinctBytes = 12
+
+// NewDescendingGenerator is like NewGenerator except that
+// the counter decreases by one for each UUID generated
+// rather than increasing. Combined with the big-endian
+// counter order returned by UUID.NetworkBytes, this means
+// that each UUID sorts before the ones generated earlier.
+//
+// UUIDs remain unique until the counter wraps around to its
+// starting value after 2^64 calls. Note that the counter
+// may pass through zero before then, at which point it
+// wraps to the largest value and the sort order is broken.
+func NewDescendingGenerator() (*Generator, error) {
+	g, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	g.skip = ^uint64(0) - 1
+	return g, nil
+}
+
+// NewStridedGenerator is like NewGenerator except that the
+// counter of the first UUID is offset and the counter increases
+// by stride for each subsequent UUID. It returns an error if
+// stride is zero.
+//
+// This allows M coordinated generators to produce disjoint sets of
+// counters: if they are created with offsets 0 to M-1 and a stride
+// of M, no two of them ever produce the same counter until the
+// counters wrap around after 2^64/M calls, or at all if M is a
+// power of two.
+func NewStridedGenerator(offset, stride uint64) (*Generator, error) {
+	if stride == 0 {
+		return nil, errors.New("zero stride passed to NewStridedGenerator")
+	}
+	g, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	g.counter = offset - stride
+	g.skip = stride - 1
+	return g, nil
+}
+
 // MustNewGenerator is like NewGenerator
 // but panics on failure.
 func MustNewGenerator() *Generator {
@@ -66,19 +212,107 @@ func MustNewGenerator() *Generator {
 // UUID, so taking a slice of the first 16 bytes
 // is sufficient to provide a somewhat less secure 128 bit UUID.
 //
+// The first 8 bytes hold the counter in little-endian order,
+// so they alone are unique across all the UUIDs returned by
+// a generator until the counter wraps after 2^64 calls.
+//
 // It is OK to call this method concurrently.
 func (g *Generator) Next() [24]byte {
-	x := atomic.AddUint64(&g.counter, 1)
+	x := atomic.AddUint64(&g.counter, g.skip+1)
+	if tail := atomic.LoadPointer(&g.tail); tail != nil {
+		return g.nextWithTail(x, (*[16]byte)(tail))
+	}
+	uuid := g.seed
+	binary.LittleEndian.PutUint64(uuid[:8], x)
+	return uuid
+}
+
+// nextWithTail returns the UUID with counter x once
+// SetSeedTail has been called. It is kept out of Next
+// so that copying the seed stays cheap in the common case.
+func (g *Generator) nextWithTail(x uint64, tail *[16]byte) [24]byte {
 	uuid := g.seed
+	copy(uuid[8:], tail[:])
 	binary.LittleEndian.PutUint64(uuid[:8], x)
 	return uuid
 }
 
+// loadSeed returns the seed of the generator, with
+// the tail set by SetSeedTail if there is one.
+func (g *Generator) loadSeed() [24]byte {
+	seed := g.seed
+	if tail := (*[16]byte)(atomic.LoadPointer(&g.tail)); tail != nil {
+		copy(seed[8:], tail[:])
+	}
+	return seed
+}
+
+// SetSeedTail replaces the last 16 bytes of the generator's
+// seed with tail, so that subsequent UUIDs end with tail.
+// The counter is not changed, so the sequence of counter
+// values continues uninterrupted.
+//
+// Note that the tail includes bytes 10 and 11, so calling
+// SetSeedTail on a generator returned by NewWorkerGenerator
+// replaces its worker ID with the corresponding bytes of tail.
+//
+// It is OK to call this method concurrently with Next.
+// A call to Next that is in progress when SetSeedTail is called
+// returns a UUID with either the old or the new tail, never a
+// mixture of the two. All calls to Next that start after
+// SetSeedTail has returned use the new tail.
+func (g *Generator) SetSeedTail(tail [16]byte) {
+	atomic.StorePointer(&g.tail, unsafe.Pointer(&tail))
+}
+
+// NextWithPrev is like Next but also returns the UUID
+// immediately preceding cur in the generator's sequence,
+// which differs from cur only in the counter. The preceding
+// UUID is reconstructed from the counter, so it is not
+// necessarily one that has been returned by the generator.
+//
+// This is only meaningful for the layout used by Next.
+//
+// It is OK to call this method concurrently.
+func (g *Generator) NextWithPrev() (cur, prev [24]byte) {
+	x := atomic.AddUint64(&g.counter, g.skip+1)
+	cur = g.loadSeed()
+	prev = cur
+	binary.LittleEndian.PutUint64(cur[:8], x)
+	binary.LittleEndian.PutUint64(prev[:8], x-(g.skip+1))
+	return cur, prev
+}
+
+// NextExcluding is like Next but skips any UUIDs
+// that are present in exclude. Because each call to Next
+// returns a different UUID, it calls Next at most
+// len(exclude)+1 times, and usually just once.
+//
+// It is OK to call this method concurrently.
+func (g *Generator) NextExcluding(exclude map[[24]byte]struct{}) [24]byte {
+	for {
+		uuid := g.Next()
+		if _, ok := exclude[uuid]; !ok {
+			return uuid
+		}
+	}
+}
+
 // Hex128 is a convenience method that returns Hex128(g.Next()).
 func (g *Generator) Hex128() string {
 	return Hex128(g.Next())
 }
 
+// String returns a description of the generator for debugging.
+// It includes the current counter value and the last 16 bytes
+// of the seed as most recently set by the constructor or
+// SetSeedTail, but not the seed's initial counter value.
+func (g *Generator) String() string {
+	seed := g.loadSeed()
+	return "fastuuid.Generator{seed: " + hex.EncodeToString(seed[8:]) +
+		", counter: " + strconv.FormatUint(atomic.LoadUint64(&g.counter), 10) + "}"
+}
+
 // Hex128 returns an RFC4122 V4 representation of the
 // first 128 bits of the given UUID. For example:
 //
@@ -116,13 +350,153 @@ func Hex128(uuid [24]byte) string {
 	return string(b)
 }
 
+// Hex128Raw returns the first 128 bits of the given UUID
+// in the same dashed 8-4-4-4-12 layout as Hex128.
+//
+// Unlike Hex128, it does not swap any bytes or set the
+// version and variant bits, so the result is an exact
+// hex encoding of the first 16 bytes of uuid but is not
+// necessarily a valid RFC4122 V4 UUID. ParseHex128 can
+// be used to recover the bytes.
+func Hex128Raw(uuid [24]byte) string {
+	b := make([]byte, Hex128Len)
+	hex.Encode(b[0:8], uuid[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], uuid[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], uuid[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], uuid[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], uuid[10:16])
+	return string(b)
+}
+
+// hexDigits holds the lower and upper case hex digits.
+var hexDigits = [2]string{"0123456789abcdef", "0123456789ABCDEF"}
+
+// Hex128Case is like Hex128 but uses upper case
+// hex digits if upper is true.
+func Hex128Case(uuid [24]byte, upper bool) string {
+	digits := hexDigits[0]
+	if upper {
+		digits = hexDigits[1]
+	}
+	uuid = v4Bytes(uuid)
+	var b [Hex128Len]byte
+	encodeHex(b[0:8], uuid[0:4], digits)
+	b[8] = '-'
+	encodeHex(b[9:13], uuid[4:6], digits)
+	b[13] = '-'
+	encodeHex(b[14:18], uuid[6:8], digits)
+	b[18] = '-'
+	encodeHex(b[19:23], uuid[8:10], digits)
+	b[23] = '-'
+	encodeHex(b[24:], uuid[10:16], digits)
+	return string(b[:])
+}
+
+// encodeHex is like hex.Encode but uses the given digits.
+func encodeHex(dst, src []byte, digits string) {
+	for i, c := range src {
+		dst[i*2] = digits[c>>4]
+		dst[i*2+1] = digits[c&0x0f]
+	}
+}
+
+// JoinHex128 returns the Hex128 representations of all the
+// given UUIDs, each enclosed in single quotes and separated by sep.
+// With a separator of ", " the result is suitable for use in an
+// SQL IN clause, for example
+//
+//	'01000000-0000-4000-8000-000000000000', '02000000-0000-4000-8000-000000000000'
+//
+// Hex128 strings never contain quotes, so no escaping is needed.
+func JoinHex128(uuids []UUID, sep string) string {
+	if len(uuids) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.Grow(len(uuids)*(Hex128Len+2) + (len(uuids)-1)*len(sep))
+	var buf [Hex128Len + 2]byte
+	for i, uuid := range uuids {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		q := append(buf[:0], '\'')
+		q = AppendHex128(q, uuid)
+		b.Write(append(q, '\''))
+	}
+	return b.String()
+}
+
+// AppendHex128 appends the Hex128 representation of uuid to dst
+// and returns the extended buffer. If dst has room for Hex128Len
+// more bytes, it does not allocate, so by reusing a buffer the
+// string conversion done by Hex128 can be avoided entirely:
+// Hex128 makes one 48 byte allocation per call, where
+// AppendHex128 into a reused buffer makes none.
+func AppendHex128(dst []byte, uuid [24]byte) []byte {
+	uuid = v4Bytes(uuid)
+	n := len(dst)
+	dst = append(dst, make([]byte, Hex128Len)...)
+	b := dst[n:]
+	hex.Encode(b[0:8], uuid[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], uuid[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], uuid[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], uuid[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], uuid[10:16])
+	return dst
+}
+
+// Hex128Sep is like Hex128 but separates the groups of hex
+// digits with sep instead of a dash. If sep is zero, no separator
+// is used and the result is the same as HexCompact128.
+func Hex128Sep(uuid [24]byte, sep byte) string {
+	var buf [Hex128Len]byte
+	return string(AppendHex128Sep(buf[:0], uuid, sep))
+}
+
+// AppendHex128Sep appends the Hex128Sep representation of
+// uuid to dst and returns the extended buffer. Like AppendHex128,
+// it does not allocate if dst has sufficient capacity.
+func AppendHex128Sep(dst []byte, uuid [24]byte, sep byte) []byte {
+	if sep == 0 {
+		return AppendHexCompact128(dst, uuid)
+	}
+	n := len(dst)
+	dst = AppendHex128(dst, uuid)
+	b := dst[n:]
+	b[8], b[13], b[18], b[23] = sep, sep, sep, sep
+	return dst
+}
+
+// v4Bytes returns uuid with its first 16 bytes rearranged
+// and marked as an RFC4122 V4 UUID, as encoded by Hex128.
+func v4Bytes(uuid [24]byte) [24]byte {
+	// As fastuuid only varies the first 8 bytes of the UUID and we
+	// don't want to lose any of that variance, swap the UUID
+	// version byte in that range for one outside it.
+	uuid[6], uuid[9] = uuid[9], uuid[6]
+
+	// Version 4.
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	// RFC4122 variant.
+	uuid[8] = uuid[8]&0x3f | 0x80
+	return uuid
+}
+
 // ValidHex128 reports whether id is a valid UUID as returned by Hex128
 // and various other UUID packages, such as github.com/satori/go.uuid's
 // NewV4 function.
 //
 // Note that it does not allow upper case hex.
 func ValidHex128(id string) bool {
-	if len(id) != 36 {
+	if len(id) != Hex128Len {
 		return false
 	}
 	if id[8] != '-' || id[13] != '-' || id[18] != '-' || id[23] != '-' {
@@ -135,6 +509,64 @@ func ValidHex128(id string) bool {
 		isValidHex(id[24:])
 }
 
+// ParseHex128 parses a UUID in the format accepted by
+// ValidHex128. The hex digits are decoded in order into the
+// first 16 bytes of the result; the remaining bytes are zero,
+// making it the inverse of Hex128Raw.
+//
+// Note that this does not undo the byte swap or the version
+// and variant bits applied by Hex128.
+func ParseHex128(s string) ([24]byte, error) {
+	if !ValidHex128(s) {
+		return [24]byte{}, errors.New("invalid UUID " + strconv.Quote(s))
+	}
+	return ParseHex128CI(s)
+}
+
+// ParseHex128CI is like ParseHex128 but allows
+// upper, lower or mixed case hex.
+func ParseHex128CI(s string) ([24]byte, error) {
+	var uuid [24]byte
+	if len(s) != Hex128Len || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return uuid, errors.New("invalid UUID " + strconv.Quote(s))
+	}
+	if !decodeHexCI(uuid[0:4], s[0:8]) ||
+		!decodeHexCI(uuid[4:6], s[9:13]) ||
+		!decodeHexCI(uuid[6:8], s[14:18]) ||
+		!decodeHexCI(uuid[8:10], s[19:23]) ||
+		!decodeHexCI(uuid[10:16], s[24:]) {
+		return [24]byte{}, errors.New("invalid UUID " + strconv.Quote(s))
+	}
+	return uuid, nil
+}
+
+// decodeHexCI decodes the hex digits in s, which may be
+// of either case, into dst, which must be len(s)/2 bytes long.
+// It reports whether s was valid.
+func decodeHexCI(dst []byte, s string) bool {
+	for i := range dst {
+		hi, ok1 := fromHexCharCI(s[i*2])
+		lo, ok2 := fromHexCharCI(s[i*2+1])
+		if !ok1 || !ok2 {
+			return false
+		}
+		dst[i] = hi<<4 | lo
+	}
+	return true
+}
+
+func fromHexCharCI(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
 func isValidHex(s string) bool {
 	for i := 0; i < len(s); i++ {
 		c := s[i]
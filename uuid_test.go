@@ -3,9 +3,45 @@ package fastuuid
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 )
 
+func TestAsUUID(t *testing.T) {
+	var b [24]byte
+	u := AsUUID(&b)
+	if !u.IsZero() {
+		t.Fatalf("unexpected non-zero UUID")
+	}
+	b[3] = 1
+	if u[3] != 1 || u.IsZero() {
+		t.Fatalf("change to array not visible through UUID")
+	}
+	u[20] = 2
+	if b[20] != 2 {
+		t.Fatalf("change to UUID not visible through array")
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	if !(UUID{}).IsZero() {
+		t.Fatalf("zero UUID is not zero")
+	}
+	for i := 0; i < 24; i++ {
+		var uuid UUID
+		uuid[i] = 0x80
+		if uuid.IsZero() {
+			t.Fatalf("UUID %x is zero", uuid)
+		}
+	}
+	if UUID(MustNewGenerator().Next()).IsZero() {
+		t.Fatalf("generated UUID is zero")
+	}
+}
+
 func TestUUID(t *testing.T) {
 	var buf [24]byte
 	for i := range buf {
@@ -30,6 +66,205 @@ func TestUUID(t *testing.T) {
 	}
 }
 
+var newGeneratorCheckedTests = []struct {
+	about       string
+	seed        []byte
+	expectError string
+}{{
+	about: "distinct bytes",
+	seed:  []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24},
+}, {
+	about:       "all zero",
+	seed:        make([]byte, 24),
+	expectError: "random seed is all zero",
+}, {
+	about:       "repeating pattern",
+	seed:        bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 6),
+	expectError: "random seed has suspiciously low entropy",
+}}
+
+func TestNewGeneratorChecked(t *testing.T) {
+	for _, test := range newGeneratorCheckedTests {
+		t.Run(test.about, func(t *testing.T) {
+			oldReader := rand.Reader
+			rand.Reader = bytes.NewReader(test.seed)
+			g, err := NewGeneratorChecked()
+			rand.Reader = oldReader
+			if test.expectError != "" {
+				if err == nil || err.Error() != test.expectError {
+					t.Fatalf("unexpected error; got %v want %q", err, test.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot make generator: %v", err)
+			}
+			if g == nil {
+				t.Fatalf("nil generator")
+			}
+		})
+	}
+}
+
+func TestGeneratorString(t *testing.T) {
+	var buf [24]byte
+	for i := range buf {
+		buf[i] = byte(i) + 1
+	}
+	oldReader := rand.Reader
+	rand.Reader = bytes.NewReader(buf[:])
+	g, err := NewGenerator()
+	rand.Reader = oldReader
+	if err != nil {
+		t.Fatalf("cannot make generator: %v", err)
+	}
+	g.Next()
+	got, want := fmt.Sprint(g), "fastuuid.Generator{seed: 090a0b0c0d0e0f101112131415161718, counter: 578437695752307202}"
+	if got != want {
+		t.Fatalf("unexpected String result; got %q want %q", got, want)
+	}
+}
+
+func TestZeroGenerator(t *testing.T) {
+	var g Generator
+	for i := 1; i <= 3; i++ {
+		if got, want := g.Next(), [24]byte{byte(i)}; got != want {
+			t.Fatalf("unexpected UUID from zero Generator; got %x want %x", got, want)
+		}
+	}
+	if got, want := g.String(), "fastuuid.Generator{seed: 00000000000000000000000000000000, counter: 3}"; got != want {
+		t.Fatalf("unexpected String result; got %q want %q", got, want)
+	}
+	if got, want := g.RevealOpaque(g.NextOpaque()), [24]byte{4}; got != want {
+		t.Fatalf("unexpected revealed UUID; got %x want %x", got, want)
+	}
+}
+
+func TestCopyGenerator(t *testing.T) {
+	g := MustNewGenerator()
+	g.Next()
+	g1 := *g
+	for i := 0; i < 3; i++ {
+		if got, want := g1.Next(), g.Next(); got != want {
+			t.Fatalf("unexpected UUID from copy; got %x want %x", got, want)
+		}
+	}
+	g.SetSeedTail([16]byte{1})
+	if got, want := g1.Next(), g.Next(); [16]byte(got[8:]) == [16]byte(want[8:]) {
+		t.Fatalf("SetSeedTail on original affected copy")
+	}
+}
+
+// fakeSource is a Source that returns UUIDs
+// with successive first bytes.
+type fakeSource struct {
+	n byte
+}
+
+func (s *fakeSource) Next() [24]byte {
+	s.n++
+	return [24]byte{s.n}
+}
+
+// newIDs is an example of code that depends on a Source
+// rather than directly on a *Generator.
+func newIDs(src Source, n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = Hex128(src.Next())
+	}
+	return ids
+}
+
+func TestSource(t *testing.T) {
+	b := NewBufferedGenerator(MustNewGenerator(), 0)
+	defer b.Close()
+	for _, src := range []Source{MustNewGenerator(), b, NewRecentGenerator(MustNewGenerator(), 1)} {
+		if src.Next() == src.Next() {
+			t.Fatalf("%T returned the same UUID twice", src)
+		}
+	}
+
+	ids := newIDs(&fakeSource{}, 2)
+	want := []string{
+		"01000000-0000-4000-8000-000000000000",
+		"02000000-0000-4000-8000-000000000000",
+	}
+	if !reflect.DeepEqual(ids, want) {
+		t.Fatalf("unexpected ids; got %q want %q", ids, want)
+	}
+}
+
+func TestDescendingGenerator(t *testing.T) {
+	g, err := NewDescendingGenerator()
+	if err != nil {
+		t.Fatalf("cannot make generator: %v", err)
+	}
+	// Avoid the counter wrapping through zero during the test.
+	g.counter = 1 << 40
+	prev := UUID(g.Next()).NetworkBytes()
+	m := map[[24]byte]bool{prev: true}
+	for i := 0; i < 1000; i++ {
+		cur := UUID(g.Next()).NetworkBytes()
+		if bytes.Compare(cur[:], prev[:]) >= 0 {
+			t.Fatalf("UUIDs not descending; %x >= %x", cur, prev)
+		}
+		if m[cur] {
+			t.Fatalf("non-unique uuid %x", cur)
+		}
+		m[cur] = true
+		prev = cur
+	}
+	cur, prevUUID := g.NextWithPrev()
+	if got, want := binary.LittleEndian.Uint64(prevUUID[:8]), binary.LittleEndian.Uint64(cur[:8])+1; got != want {
+		t.Fatalf("unexpected previous counter from descending generator; got %d want %d", got, want)
+	}
+}
+
+func TestStridedGenerator(t *testing.T) {
+	const stride = 3
+	counters := make(map[uint64]uint64)
+	for offset := uint64(0); offset < stride; offset++ {
+		g, err := NewStridedGenerator(offset, stride)
+		if err != nil {
+			t.Fatalf("cannot make generator: %v", err)
+		}
+		for i := uint64(0); i < 1000; i++ {
+			x := CounterOf(g.Next())
+			if want := offset + i*stride; x != want {
+				t.Fatalf("unexpected counter; got %d want %d", x, want)
+			}
+			if other, ok := counters[x]; ok {
+				t.Fatalf("generators with offsets %d and %d share counter %d", other, offset, x)
+			}
+			counters[x] = offset
+		}
+		cur, prev := g.NextWithPrev()
+		if got, want := CounterOf(prev), CounterOf(cur)-stride; got != want {
+			t.Fatalf("unexpected previous counter from strided generator; got %d want %d", got, want)
+		}
+	}
+	if _, err := NewStridedGenerator(0, 0); err == nil {
+		t.Fatalf("expected error for zero stride")
+	}
+}
+
+func TestNextPrefixUnique(t *testing.T) {
+	g := MustNewGenerator()
+	// Start just below a counter wrap of the low bytes.
+	g.counter = 1<<32 - 10
+	m := make(map[[8]byte]bool)
+	for i := 0; i < 100; i++ {
+		uuid := g.Next()
+		var prefix [8]byte
+		copy(prefix[:], uuid[:8])
+		if m[prefix] {
+			t.Fatalf("non-unique 8 byte prefix %x", prefix)
+		}
+		m[prefix] = true
+	}
+}
+
 const step = 32768
 
 func TestUniqueness(t *testing.T) {
@@ -60,6 +295,97 @@ func TestUniqueness(t *testing.T) {
 	}
 }
 
+func TestNextWithPrev(t *testing.T) {
+	g := MustNewGenerator()
+	last := g.Next()
+	for i := 0; i < 10; i++ {
+		cur, prev := g.NextWithPrev()
+		if prev != last {
+			t.Fatalf("unexpected previous UUID; got %x want %x", prev, last)
+		}
+		if cur == prev {
+			t.Fatalf("current and previous UUIDs are equal: %x", cur)
+		}
+		if !bytes.Equal(cur[8:], prev[8:]) {
+			t.Fatalf("current and previous UUIDs differ outside the counter: %x vs %x", cur, prev)
+		}
+		last = cur
+	}
+}
+
+func TestSetSeedTail(t *testing.T) {
+	g := MustNewGenerator()
+	before := g.Next()
+	tail := [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	g.SetSeedTail(tail)
+	after := g.Next()
+	if !bytes.Equal(after[8:], tail[:]) {
+		t.Fatalf("unexpected tail after SetSeedTail; got %x want %x", after[8:], tail)
+	}
+	if bytes.Equal(before[8:], after[8:]) {
+		t.Fatalf("tail did not change")
+	}
+	c0 := binary.LittleEndian.Uint64(before[:8])
+	c1 := binary.LittleEndian.Uint64(after[:8])
+	if c1 != c0+1 {
+		t.Fatalf("unexpected counter after SetSeedTail; got %d want %d", c1, c0+1)
+	}
+}
+
+func TestSetSeedTailConcurrent(t *testing.T) {
+	g := MustNewGenerator()
+	first := g.Next()
+	tails := [][16]byte{{1}, {2}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			g.SetSeedTail(tails[i%2])
+		}
+	}()
+	prev := binary.LittleEndian.Uint64(first[:8])
+	for i := 0; i < 1000; i++ {
+		uuid := g.Next()
+		tail := uuid[8:]
+		if !bytes.Equal(tail, first[8:]) && !bytes.Equal(tail, tails[0][:]) && !bytes.Equal(tail, tails[1][:]) {
+			t.Fatalf("unexpected tail %x", tail)
+		}
+		c := binary.LittleEndian.Uint64(uuid[:8])
+		if c != prev+1 {
+			t.Fatalf("unexpected counter; got %d want %d", c, prev+1)
+		}
+		prev = c
+	}
+	<-done
+	uuid := g.Next()
+	if !bytes.Equal(uuid[8:], tails[1][:]) {
+		t.Fatalf("unexpected final tail %x", uuid[8:])
+	}
+}
+
+func TestNextExcluding(t *testing.T) {
+	g := MustNewGenerator()
+	counter := g.counter
+	exclude := make(map[[24]byte]struct{})
+	// Exclude the next few UUIDs and some others.
+	for i := 0; i < 5; i++ {
+		exclude[g.Next()] = struct{}{}
+	}
+	for i := 0; i < 5; i++ {
+		exclude[[24]byte{byte(i)}] = struct{}{}
+	}
+	g.counter = counter
+	for i := 0; i < 10; i++ {
+		uuid := g.NextExcluding(exclude)
+		if _, ok := exclude[uuid]; ok {
+			t.Fatalf("NextExcluding returned excluded UUID %x", uuid)
+		}
+	}
+	if got, want := g.counter, counter+15; got != want {
+		t.Fatalf("unexpected counter; got %d want %d", got, want)
+	}
+}
+
 func TestHex128(t *testing.T) {
 	var b [24]byte
 	for i := range b {
@@ -72,6 +398,169 @@ func TestHex128(t *testing.T) {
 	}
 }
 
+func TestLengthConstants(t *testing.T) {
+	uuid := MustNewGenerator().Next()
+	if got := len(uuid); got != RawLen {
+		t.Fatalf("unexpected raw length; got %d want %d", got, RawLen)
+	}
+	if got := len(Hex128(uuid)); got != Hex128Len {
+		t.Fatalf("unexpected Hex128 length; got %d want %d", got, Hex128Len)
+	}
+	if got := len(HexCompact128(uuid)); got != HexCompact128Len {
+		t.Fatalf("unexpected HexCompact128 length; got %d want %d", got, HexCompact128Len)
+	}
+	if got := len(Base64(uuid)); got != Base64Len {
+		t.Fatalf("unexpected Base64 length; got %d want %d", got, Base64Len)
+	}
+}
+
+func TestAppendHex128(t *testing.T) {
+	g := MustNewGenerator()
+	uuid := g.Next()
+	if got, want := string(AppendHex128([]byte("x"), uuid)), "x"+Hex128(uuid); got != want {
+		t.Fatalf("unexpected AppendHex128 result; got %q want %q", got, want)
+	}
+	buf := make([]byte, 0, Hex128Len)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendHex128(buf[:0], uuid)
+	})
+	if allocs != 0 {
+		t.Fatalf("unexpected allocations; got %v want 0", allocs)
+	}
+}
+
+func TestHex128Raw(t *testing.T) {
+	var b [24]byte
+	for i := range b {
+		b[i] = byte(i + 1)
+	}
+	got, want := Hex128Raw(b), "01020304-0506-0708-090a-0b0c0d0e0f10"
+	if got != want {
+		t.Fatalf("unexpected Hex128Raw result; got %q want %q", got, want)
+	}
+}
+
+func TestHex128RawRoundTrip(t *testing.T) {
+	g := MustNewGenerator()
+	lossy := 0
+	for i := 0; i < 100; i++ {
+		var want [24]byte
+		uuid := g.Next()
+		copy(want[:16], uuid[:16])
+		got, err := ParseHex128(Hex128Raw(uuid))
+		if err != nil {
+			t.Fatalf("cannot parse: %v", err)
+		}
+		if got != want {
+			t.Fatalf("Hex128Raw round trip mismatch; got %x want %x", got, want)
+		}
+		got, err = ParseHex128(Hex128(uuid))
+		if err != nil {
+			t.Fatalf("cannot parse: %v", err)
+		}
+		if got != want {
+			lossy++
+		}
+	}
+	if lossy == 0 {
+		t.Fatalf("Hex128 unexpectedly round tripped every UUID")
+	}
+}
+
+var hex128SepTests = []struct {
+	sep  byte
+	want string
+}{{
+	sep:  '-',
+	want: "01020304-0506-4a08-8907-0b0c0d0e0f10",
+}, {
+	sep:  ':',
+	want: "01020304:0506:4a08:8907:0b0c0d0e0f10",
+}, {
+	sep:  ' ',
+	want: "01020304 0506 4a08 8907 0b0c0d0e0f10",
+}, {
+	sep:  0,
+	want: "0102030405064a0889070b0c0d0e0f10",
+}}
+
+func TestHex128Sep(t *testing.T) {
+	var b [24]byte
+	for i := range b {
+		b[i] = byte(i + 1)
+	}
+	for _, test := range hex128SepTests {
+		if got := Hex128Sep(b, test.sep); got != test.want {
+			t.Errorf("unexpected Hex128Sep result for %q; got %q want %q", test.sep, got, test.want)
+		}
+		if got := string(AppendHex128Sep([]byte("x"), b, test.sep)); got != "x"+test.want {
+			t.Errorf("unexpected AppendHex128Sep result for %q; got %q want %q", test.sep, got, "x"+test.want)
+		}
+		buf := make([]byte, 0, Hex128Len)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = AppendHex128Sep(buf[:0], b, test.sep)
+		})
+		if allocs != 0 {
+			t.Errorf("unexpected allocations for %q; got %v want 0", test.sep, allocs)
+		}
+	}
+}
+
+func TestHex128Case(t *testing.T) {
+	var b [24]byte
+	for i := range b {
+		b[i] = byte(i + 0xa1)
+	}
+	lower := Hex128Case(b, false)
+	if want := Hex128(b); lower != want {
+		t.Fatalf("unexpected lower case result; got %q want %q", lower, want)
+	}
+	upper := Hex128Case(b, true)
+	if want := "A1A2A3A4-A5A6-4AA8-A9A7-ABACADAEAFB0"; upper != want {
+		t.Fatalf("unexpected upper case result; got %q want %q", upper, want)
+	}
+	if strings.ToLower(upper) != lower {
+		t.Fatalf("upper and lower case results differ: %q vs %q", upper, lower)
+	}
+}
+
+var joinHex128Tests = []struct {
+	about string
+	uuids []UUID
+	sep   string
+	want  string
+}{{
+	about: "no uuids",
+	sep:   ",",
+	want:  "",
+}, {
+	about: "one uuid",
+	uuids: []UUID{{1}},
+	sep:   ",",
+	want:  "'01000000-0000-4000-8000-000000000000'",
+}, {
+	about: "several uuids",
+	uuids: []UUID{{1}, {2}, {3}},
+	sep:   ", ",
+	want:  "'01000000-0000-4000-8000-000000000000', '02000000-0000-4000-8000-000000000000', '03000000-0000-4000-8000-000000000000'",
+}}
+
+func TestJoinHex128(t *testing.T) {
+	for _, test := range joinHex128Tests {
+		t.Run(test.about, func(t *testing.T) {
+			got := JoinHex128(test.uuids, test.sep)
+			if got != test.want {
+				t.Fatalf("unexpected JoinHex128 result; got %q want %q", got, test.want)
+			}
+			if n := len(test.uuids); n > 0 {
+				if want := n*(Hex128Len+2) + (n-1)*len(test.sep); len(got) != want {
+					t.Fatalf("unexpected length; got %d want %d", len(got), want)
+				}
+			}
+		})
+	}
+}
+
 var validHex128Tests = []struct {
 	u     string
 	valid bool
@@ -114,6 +603,104 @@ func TestValidHex128(t *testing.T) {
 	}
 }
 
+func TestParseHex128(t *testing.T) {
+	for _, test := range validHex128Tests {
+		t.Run(test.u, func(t *testing.T) {
+			uuid, err := ParseHex128(test.u)
+			if !test.valid {
+				if err == nil {
+					t.Fatalf("expected error, got %x", uuid)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := Hex128Raw(uuid); got != test.u {
+				t.Fatalf("round trip mismatch; got %q want %q", got, test.u)
+			}
+		})
+	}
+	if uuid, err := ParseHex128("0A0B0C0D-0E0F-A0B0-C0D0-E0F0AABBCCDD"); err == nil {
+		t.Fatalf("expected error for upper case hex, got %x", uuid)
+	}
+}
+
+func FuzzParseHex128(f *testing.F) {
+	for _, test := range validHex128Tests {
+		f.Add(test.u)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		uuid, err := ParseHex128(s)
+		if ValidHex128(s) != (err == nil) {
+			t.Fatalf("ParseHex128 and ValidHex128 disagree on %q; error %v", s, err)
+		}
+		if err != nil {
+			return
+		}
+		if got := Hex128Raw(uuid); got != s {
+			t.Fatalf("round trip mismatch; got %q want %q", got, s)
+		}
+	})
+}
+
+var parseHex128CITests = []struct {
+	s         string
+	want      [24]byte
+	expectErr bool
+}{{
+	s:    "01020304-0506-0708-090a-0b0c0d0e0f10",
+	want: [24]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+}, {
+	s:    "0A0B0C0D-0E0F-A0B0-C0D0-E0F0AABBCCDD",
+	want: [24]byte{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xa0, 0xb0, 0xc0, 0xd0, 0xe0, 0xf0, 0xaa, 0xbb, 0xcc, 0xdd},
+}, {
+	s:    "0a0B0c0D-0e0F-a0B0-c0D0-e0F0aAbBcCdD",
+	want: [24]byte{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0xa0, 0xb0, 0xc0, 0xd0, 0xe0, 0xf0, 0xaa, 0xbb, 0xcc, 0xdd},
+}, {
+	s:         "01020304-0506-0708-090a-0b0c0d0e0f1",
+	expectErr: true,
+}, {
+	s:         "01020304_0506-0708-090a-0b0c0d0e0f10",
+	expectErr: true,
+}, {
+	s:         "01020304-0506-0708-090a-0b0c0d0e0f1g",
+	expectErr: true,
+}, {
+	s:         "{1020304-0506-0708-090a-0b0c0d0e0f1}",
+	expectErr: true,
+}, {
+	s:         "urn:0304-0506-0708-090a-0b0c0d0e0f10",
+	expectErr: true,
+}}
+
+func TestParseHex128CI(t *testing.T) {
+	for _, test := range parseHex128CITests {
+		t.Run(test.s, func(t *testing.T) {
+			got, err := ParseHex128CI(test.s)
+			if test.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got %x", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("unexpected result; got %x want %x", got, test.want)
+			}
+			lower, err := ParseHex128CI(strings.ToLower(test.s))
+			if err != nil {
+				t.Fatalf("cannot parse lower case form: %v", err)
+			}
+			if lower != got {
+				t.Fatalf("lower case form decodes differently; got %x want %x", lower, got)
+			}
+		})
+	}
+}
+
 var _s string
 
 func BenchmarkHex128(b *testing.B) {
@@ -123,6 +710,24 @@ func BenchmarkHex128(b *testing.B) {
 	}
 }
 
+func BenchmarkAppendHex128(b *testing.B) {
+	g := MustNewGenerator()
+	buf := make([]byte, 0, Hex128Len)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendHex128(buf[:0], g.Next())
+	}
+}
+
+func BenchmarkAppendHex128Sep(b *testing.B) {
+	g := MustNewGenerator()
+	buf := make([]byte, 0, Hex128Len)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendHex128Sep(buf[:0], g.Next(), ':')
+	}
+}
+
 func BenchmarkNext(b *testing.B) {
 	g := MustNewGenerator()
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,83 @@
+package fastuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync"
+	"time"
+)
+
+// v1Epoch holds the start of the RFC 4122 version 1
+// timestamp, 15 October 1582, in 100ns intervals before
+// the Unix epoch.
+const v1Epoch = 0x01b21dd213814000
+
+// V1Generator generates RFC 4122 version 1 UUIDs from the
+// current time, a clock sequence and a node ID. Unlike the
+// other generators in this package, it generates standard
+// 128 bit UUIDs.
+type V1Generator struct {
+	node [6]byte
+
+	// now is used to read the current time.
+	// It is replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// lastClock holds the clock reading, in 100ns intervals
+	// since the version 1 epoch, when the last UUID was generated.
+	lastClock uint64
+	// lastTime holds the timestamp of the last UUID generated.
+	lastTime uint64
+	clockSeq uint16
+}
+
+// NewV1Generator returns a new V1Generator that uses the given
+// node ID. The node ID is used as is; RFC 4122 calls for it to be
+// an IEEE 802 MAC address of the host or, if one is not available
+// or may not be disclosed, random bytes with the least significant
+// bit of the first byte (the multicast bit) set to 1.
+//
+// The clock sequence starts at a random value.
+// NewV1Generator panics if the crypto/rand read fails.
+func NewV1Generator(node [6]byte) *V1Generator {
+	var b [2]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return &V1Generator{
+		node:     node,
+		now:      time.Now,
+		clockSeq: binary.BigEndian.Uint16(b[:]) & 0x3fff,
+	}
+}
+
+// Next returns the next version 1 UUID.
+//
+// Each UUID has a timestamp later than the one before it unless the
+// clock has moved backwards, in which case the clock sequence is
+// incremented so that UUIDs remain unique.
+//
+// It is OK to call this method concurrently.
+func (g *V1Generator) Next() [16]byte {
+	g.mu.Lock()
+	clock := uint64(g.now().UnixNano()/100) + v1Epoch
+	t := clock
+	if clock < g.lastClock {
+		g.clockSeq = (g.clockSeq + 1) & 0x3fff
+	} else if t <= g.lastTime {
+		t = g.lastTime + 1
+	}
+	g.lastClock = clock
+	g.lastTime = t
+	clockSeq := g.clockSeq
+	g.mu.Unlock()
+
+	var uuid [16]byte
+	binary.BigEndian.PutUint32(uuid[0:4], uint32(t))
+	binary.BigEndian.PutUint16(uuid[4:6], uint16(t>>32))
+	binary.BigEndian.PutUint16(uuid[6:8], uint16(t>>48)&0x0fff|0x1000)
+	binary.BigEndian.PutUint16(uuid[8:10], clockSeq|0x8000)
+	copy(uuid[10:], g.node[:])
+	return uuid
+}
@@ -0,0 +1,83 @@
+package fastuuid
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+)
+
+// v1Fields returns the timestamp and clock sequence
+// of a version 1 UUID.
+func v1Fields(uuid [16]byte) (t uint64, clockSeq uint16) {
+	t = uint64(binary.BigEndian.Uint32(uuid[0:4])) |
+		uint64(binary.BigEndian.Uint16(uuid[4:6]))<<32 |
+		uint64(binary.BigEndian.Uint16(uuid[6:8])&0x0fff)<<48
+	return t, binary.BigEndian.Uint16(uuid[8:10]) & 0x3fff
+}
+
+func TestV1Generator(t *testing.T) {
+	node := [6]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab}
+	g := NewV1Generator(node)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	g.now = func() time.Time {
+		return now
+	}
+	uuid := g.Next()
+	if v := uuid[6] >> 4; v != 1 {
+		t.Fatalf("unexpected version %d", v)
+	}
+	if uuid[8]&0xc0 != 0x80 {
+		t.Fatalf("unexpected variant bits %#x", uuid[8]>>6)
+	}
+	// Check the string form, as parsed by other UUID packages.
+	var raw [24]byte
+	copy(raw[:], uuid[:])
+	if s := Hex128Raw(raw); s[14] != '1' || !strings.ContainsRune("89ab", rune(s[19])) {
+		t.Fatalf("string form %q does not have version 1 and the RFC 4122 variant", s)
+	}
+	if [6]byte(uuid[10:]) != node {
+		t.Fatalf("unexpected node %x", uuid[10:])
+	}
+	ts, clockSeq := v1Fields(uuid)
+	if got := time.Unix(0, int64(ts-v1Epoch)*100).UTC(); !got.Equal(now) {
+		t.Fatalf("unexpected time; got %v want %v", got, now)
+	}
+
+	// Timestamps increase even when the clock does not.
+	prev := ts
+	for i := 0; i < 5; i++ {
+		ts, seq := v1Fields(g.Next())
+		if ts <= prev {
+			t.Fatalf("timestamp did not increase; got %d after %d", ts, prev)
+		}
+		if seq != clockSeq {
+			t.Fatalf("unexpected clock sequence change")
+		}
+		prev = ts
+	}
+	now = now.Add(time.Second)
+	ts, _ = v1Fields(g.Next())
+	if ts <= prev {
+		t.Fatalf("timestamp did not increase; got %d after %d", ts, prev)
+	}
+
+	// The clock sequence changes when the clock moves backwards.
+	now = now.Add(-time.Minute)
+	_, seq := v1Fields(g.Next())
+	if want := (clockSeq + 1) & 0x3fff; seq != want {
+		t.Fatalf("unexpected clock sequence after clock regression; got %d want %d", seq, want)
+	}
+}
+
+func TestV1GeneratorUnique(t *testing.T) {
+	g := NewV1Generator([6]byte{1})
+	m := make(map[[16]byte]bool)
+	for i := 0; i < step; i++ {
+		uuid := g.Next()
+		if m[uuid] {
+			t.Fatalf("non-unique uuid %x", uuid)
+		}
+		m[uuid] = true
+	}
+}
@@ -0,0 +1,35 @@
+package fastuuid
+
+import "sync"
+
+// ValidateHex128Batch reports, for each string in ss, whether it is
+// valid according to ValidHex128. The work is split between the
+// given number of goroutines; the result is the same whatever the
+// concurrency, with element i holding the validity of ss[i].
+//
+// It panics if concurrency is not positive.
+func ValidateHex128Batch(ss []string, concurrency int) []bool {
+	if concurrency <= 0 {
+		panic("fastuuid: invalid concurrency passed to ValidateHex128Batch")
+	}
+	valid := make([]bool, len(ss))
+	if concurrency > len(ss) {
+		concurrency = len(ss)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		// Each goroutine handles a contiguous range
+		// so that they do not write to the same
+		// parts of valid.
+		start, end := i*len(ss)/concurrency, (i+1)*len(ss)/concurrency
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := start; j < end; j++ {
+				valid[j] = ValidHex128(ss[j])
+			}
+		}()
+	}
+	wg.Wait()
+	return valid
+}
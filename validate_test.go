@@ -0,0 +1,65 @@
+package fastuuid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func validateTestStrings(n int) []string {
+	g := MustNewGenerator()
+	ss := make([]string, n)
+	for i := range ss {
+		ss[i] = Hex128(g.Next())
+		switch i % 3 {
+		case 1:
+			ss[i] = ss[i][1:]
+		case 2:
+			ss[i] = ss[i][:5] + "x" + ss[i][6:]
+		}
+	}
+	return ss
+}
+
+func TestValidateHex128Batch(t *testing.T) {
+	ss := validateTestStrings(1000)
+	want := make([]bool, len(ss))
+	for i, s := range ss {
+		want[i] = ValidHex128(s)
+	}
+	for _, concurrency := range []int{1, 2, 3, 7, 1000, 2000} {
+		if got := ValidateHex128Batch(ss, concurrency); !reflect.DeepEqual(got, want) {
+			t.Fatalf("unexpected result with concurrency %d", concurrency)
+		}
+	}
+	if got := ValidateHex128Batch(nil, 4); len(got) != 0 {
+		t.Fatalf("unexpected result for no strings; got %v", got)
+	}
+}
+
+func TestValidateHex128BatchInvalidConcurrency(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+	ValidateHex128Batch([]string{""}, 0)
+}
+
+func BenchmarkValidateHex128Batch(b *testing.B) {
+	ss := validateTestStrings(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ValidateHex128Batch(ss, 8)
+	}
+}
+
+func BenchmarkValidateHex128Serial(b *testing.B) {
+	ss := validateTestStrings(100000)
+	valid := make([]bool, len(ss))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, s := range ss {
+			valid[j] = ValidHex128(s)
+		}
+	}
+}
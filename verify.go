@@ -0,0 +1,81 @@
+package fastuuid
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sync"
+)
+
+// VerifyUniqueness generates n UUIDs from src, split across the
+// given number of concurrent goroutines, and returns an error if
+// any UUID is generated more than once. It can be used as a sanity
+// check for custom generators.
+//
+// Note that it holds all n UUIDs in memory at once.
+func VerifyUniqueness(src Source, n, concurrency int) error {
+	if n < 0 || concurrency <= 0 {
+		return errors.New("invalid arguments to VerifyUniqueness")
+	}
+	results := make([][]UUID, concurrency)
+	var wg sync.WaitGroup
+	for i := range results {
+		count := n / concurrency
+		if i < n%concurrency {
+			count++
+		}
+		wg.Add(1)
+		go func(i, count int) {
+			defer wg.Done()
+			uuids := make([]UUID, count)
+			for j := range uuids {
+				uuids[j] = src.Next()
+			}
+			results[i] = uuids
+		}(i, count)
+	}
+	wg.Wait()
+	seen := make(map[UUID]struct{}, n)
+	for _, uuids := range results {
+		for _, uuid := range uuids {
+			if _, ok := seen[uuid]; ok {
+				return errors.New("duplicate UUID " + hex.EncodeToString(uuid[:]))
+			}
+			seen[uuid] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// GenerateWithDuplicates returns n UUIDs from g, in random order,
+// of which approximately the fraction dupFraction are repeats of
+// other entries in the slice. It is intended for testing code that
+// detects or removes duplicate UUIDs, and should not be used to
+// generate real identifiers.
+//
+// Exactly n - round(n*dupFraction) distinct UUIDs are present, except
+// that at least one is present when n is positive.
+//
+// It returns an error if n is negative or dupFraction is not
+// between 0 and 1.
+func GenerateWithDuplicates(g *Generator, n int, dupFraction float64) ([]UUID, error) {
+	if n < 0 || !(dupFraction >= 0 && dupFraction <= 1) {
+		return nil, errors.New("invalid arguments to GenerateWithDuplicates")
+	}
+	distinct := n - int(math.Round(float64(n)*dupFraction))
+	if distinct == 0 && n > 0 {
+		distinct = 1
+	}
+	uuids := make([]UUID, n)
+	for i := 0; i < distinct; i++ {
+		uuids[i] = g.Next()
+	}
+	for i := distinct; i < n; i++ {
+		uuids[i] = uuids[rand.IntN(distinct)]
+	}
+	rand.Shuffle(len(uuids), func(i, j int) {
+		uuids[i], uuids[j] = uuids[j], uuids[i]
+	})
+	return uuids, nil
+}
@@ -0,0 +1,112 @@
+package fastuuid
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+)
+
+func TestVerifyUniqueness(t *testing.T) {
+	if err := VerifyUniqueness(MustNewGenerator(), step, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := VerifyUniqueness(MustNewGenerator(), 7, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// repeatingSource is a Source that returns each
+// UUID twice.
+type repeatingSource struct {
+	n uint64
+}
+
+func (s *repeatingSource) Next() [24]byte {
+	n := atomic.AddUint64(&s.n, 1)
+	return [24]byte{byte(n / 2), byte(n / 512)}
+}
+
+func TestVerifyUniquenessBroken(t *testing.T) {
+	if err := VerifyUniqueness(&repeatingSource{}, 100, 4); err == nil {
+		t.Fatalf("expected error from repeating source")
+	}
+	// A generator whose counter never changes.
+	g := MustNewGenerator()
+	g.skip = ^uint64(0)
+	if err := VerifyUniqueness(g, 2, 1); err == nil {
+		t.Fatalf("expected error from stuck generator")
+	}
+}
+
+func TestVerifyUniquenessInvalidArgs(t *testing.T) {
+	g := MustNewGenerator()
+	if err := VerifyUniqueness(g, -1, 1); err == nil {
+		t.Errorf("expected error for negative n")
+	}
+	if err := VerifyUniqueness(g, 1, 0); err == nil {
+		t.Errorf("expected error for zero concurrency")
+	}
+}
+
+var generateWithDuplicatesTests = []struct {
+	n            int
+	dupFraction  float64
+	wantDistinct int
+}{
+	{0, 0.5, 0},
+	{1, 1, 1},
+	{10, 0, 10},
+	{10, 1, 1},
+	{1000, 0.25, 750},
+	{1000, 0.001, 999},
+	{7, 0.5, 3},
+}
+
+func TestGenerateWithDuplicates(t *testing.T) {
+	g := MustNewGenerator()
+	for _, test := range generateWithDuplicatesTests {
+		uuids, err := GenerateWithDuplicates(g, test.n, test.dupFraction)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(uuids) != test.n {
+			t.Fatalf("unexpected length; got %d want %d", len(uuids), test.n)
+		}
+		distinct := make(map[UUID]bool)
+		for _, uuid := range uuids {
+			if uuid == (UUID{}) {
+				t.Fatalf("zero UUID in result")
+			}
+			distinct[uuid] = true
+		}
+		if len(distinct) != test.wantDistinct {
+			t.Errorf("unexpected distinct count for n=%d, dupFraction=%v; got %d want %d", test.n, test.dupFraction, len(distinct), test.wantDistinct)
+		}
+		if test.wantDistinct < test.n {
+			if unique, _ := Unique(uuids); unique {
+				t.Errorf("Unique reports no duplicates")
+			}
+		}
+	}
+}
+
+func TestGenerateWithDuplicatesShuffled(t *testing.T) {
+	uuids, err := GenerateWithDuplicates(MustNewGenerator(), 1000, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if IsContiguous(uuids) {
+		t.Fatalf("UUIDs are not shuffled")
+	}
+}
+
+func TestGenerateWithDuplicatesInvalid(t *testing.T) {
+	for _, test := range []struct {
+		n           int
+		dupFraction float64
+	}{{-1, 0}, {1, -0.1}, {1, 1.1}, {1, math.NaN()}} {
+		if _, err := GenerateWithDuplicates(MustNewGenerator(), test.n, test.dupFraction); err == nil {
+			t.Errorf("expected error for n=%d, dupFraction=%v", test.n, test.dupFraction)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package fastuuid
+
+import "encoding/binary"
+
+// NewWorkerGenerator returns a new Generator whose UUIDs
+// all carry the given worker ID, so that UUIDs generated
+// by generators with different worker IDs never collide
+// and can be attributed to the worker that produced them.
+//
+// The worker ID is stored little-endian in bytes 10 and 11
+// of each UUID. These bytes are outside the counter and are
+// preserved by Hex128, so the worker ID is also retained
+// in the 128 bit representation.
+func NewWorkerGenerator(workerID uint16) (*Generator, error) {
+	g, err := NewGenerator()
+	if err != nil {
+		return nil, err
+	}
+	binary.LittleEndian.PutUint16(g.seed[10:12], workerID)
+	return g, nil
+}
+
+// WorkerOf returns the worker ID of a UUID generated
+// by a generator created with NewWorkerGenerator.
+// The result is meaningless for other UUIDs.
+func WorkerOf(uuid [24]byte) uint16 {
+	return binary.LittleEndian.Uint16(uuid[10:12])
+}
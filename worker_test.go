@@ -0,0 +1,17 @@
+package fastuuid
+
+import "testing"
+
+func TestWorkerGenerator(t *testing.T) {
+	for _, id := range []uint16{0, 1, 0x1234, 0xffff} {
+		g, err := NewWorkerGenerator(id)
+		if err != nil {
+			t.Fatalf("cannot make generator: %v", err)
+		}
+		for i := 0; i < 100; i++ {
+			if got := WorkerOf(g.Next()); got != id {
+				t.Fatalf("unexpected worker id; got %#x want %#x", got, id)
+			}
+		}
+	}
+}